@@ -0,0 +1,113 @@
+package tabuamare
+
+import "math"
+
+// TideType indica se uma maré é preamar (maré alta) ou baixa-mar (maré baixa)
+type TideType int
+
+const (
+	// TideUnknown é usado quando não há marés vizinhas para comparação
+	TideUnknown TideType = iota
+	// TideLow representa uma baixa-mar
+	TideLow
+	// TideHigh representa uma preamar
+	TideHigh
+)
+
+// String retorna o nome do tipo de maré
+func (t TideType) String() string {
+	switch t {
+	case TideLow:
+		return "low"
+	case TideHigh:
+		return "high"
+	}
+	return "unknown"
+}
+
+// MarshalText serializa o tipo de maré pelo seu nome
+func (t TideType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// TideExtreme é uma maré classificada como preamar ou baixa-mar
+type TideExtreme struct {
+	TideEvent
+	Type TideType `json:"type"`
+	// Range é a amplitude em metros em relação ao extremo anterior (0 no primeiro)
+	Range float64 `json:"range"`
+	// Irregular indica que o extremo tem o mesmo tipo do anterior, quebrando a alternância
+	Irregular bool `json:"irregular"`
+}
+
+// Extremes classifica as marés da tábua como preamar ou baixa-mar, considerando
+// também as marés vizinhas de outros dias e meses
+func (t TideTable) Extremes() ([]TideExtreme, error) {
+	events, err := t.Events()
+	if err != nil {
+		return nil, err
+	}
+	return ClassifyEvents(events), nil
+}
+
+// ClassifyEvents classifica eventos em ordem cronológica como preamar ou baixa-mar
+// comparando cada nível com os dos eventos vizinhos
+func ClassifyEvents(events []TideEvent) []TideExtreme {
+	extremes := make([]TideExtreme, len(events))
+
+	for i, event := range events {
+		extremes[i] = TideExtreme{
+			TideEvent: event,
+			Type:      classifyEvent(events, i),
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		prev := extremes[i-1]
+		extremes[i].Range = math.Abs(event.Level - prev.Level)
+		extremes[i].Irregular = extremes[i].Type != TideUnknown && extremes[i].Type == prev.Type
+	}
+
+	return extremes
+}
+
+// classifyEvent determina o tipo do evento i a partir dos seus vizinhos
+func classifyEvent(events []TideEvent, i int) TideType {
+	level := events[i].Level
+	hasPrev, hasNext := i > 0, i < len(events)-1
+
+	var prev, next float64
+	if hasPrev {
+		prev = events[i-1].Level
+	}
+	if hasNext {
+		next = events[i+1].Level
+	}
+
+	switch {
+	case hasPrev && hasNext && level > prev && level > next:
+		return TideHigh
+	case hasPrev && hasNext && level < prev && level < next:
+		return TideLow
+	}
+
+	// Sem um máximo ou mínimo local, compara com o vizinho que difere do nível atual
+	if hasPrev && level != prev {
+		return compareLevels(level, prev)
+	}
+	if hasNext && level != next {
+		return compareLevels(level, next)
+	}
+
+	return TideUnknown
+}
+
+// compareLevels retorna TideHigh se level está acima de other, ou TideLow caso contrário
+func compareLevels(level, other float64) TideType {
+	if level > other {
+		return TideHigh
+	}
+	return TideLow
+}
@@ -0,0 +1,81 @@
+package tabuamare
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func eventsFromLevels(levels ...float64) []TideEvent {
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	events := make([]TideEvent, len(levels))
+	for i, level := range levels {
+		events[i] = TideEvent{Time: start.Add(time.Duration(i) * 6 * time.Hour), Level: level}
+	}
+	return events
+}
+
+func TestClassifyEvents_Alternating(t *testing.T) {
+	extremes := ClassifyEvents(eventsFromLevels(1.9, 0.3, 2.0, 0.2))
+
+	want := []TideType{TideHigh, TideLow, TideHigh, TideLow}
+	for i, extreme := range extremes {
+		if extreme.Type != want[i] {
+			t.Errorf("event %d: expected %s, got %s", i, want[i], extreme.Type)
+		}
+		if extreme.Irregular {
+			t.Errorf("event %d: expected regular alternation", i)
+		}
+	}
+
+	if extremes[0].Range != 0 {
+		t.Errorf("expected range 0 for the first event, got %f", extremes[0].Range)
+	}
+	if math.Abs(extremes[2].Range-1.7) > 1e-9 {
+		t.Errorf("expected range 1.7, got %f", extremes[2].Range)
+	}
+}
+
+func TestClassifyEvents_DiurnalInequality(t *testing.T) {
+	// Preamar baixa abaixo do nível médio e baixa-mar alta acima dele
+	extremes := ClassifyEvents(eventsFromLevels(2.4, 1.4, 1.6, 0.1))
+
+	want := []TideType{TideHigh, TideLow, TideHigh, TideLow}
+	for i, extreme := range extremes {
+		if extreme.Type != want[i] {
+			t.Errorf("event %d: expected %s, got %s", i, want[i], extreme.Type)
+		}
+	}
+}
+
+func TestClassifyEvents_FlagsNonAlternatingSequence(t *testing.T) {
+	extremes := ClassifyEvents(eventsFromLevels(0.2, 1.1, 1.8, 0.4))
+
+	if extremes[1].Type != TideHigh || extremes[2].Type != TideHigh {
+		t.Fatalf("expected two consecutive highs, got %s and %s", extremes[1].Type, extremes[2].Type)
+	}
+	if !extremes[2].Irregular {
+		t.Error("expected the second consecutive high to be flagged as irregular")
+	}
+}
+
+func TestClassifyEvents_SingleEvent(t *testing.T) {
+	extremes := ClassifyEvents(eventsFromLevels(1.2))
+	if extremes[0].Type != TideUnknown {
+		t.Errorf("expected unknown type, got %s", extremes[0].Type)
+	}
+}
+
+func TestTideTable_ExtremesAcrossDays(t *testing.T) {
+	extremes, err := sampleTideTable().Extremes()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := []TideType{TideHigh, TideLow, TideHigh, TideLow}
+	for i, extreme := range extremes {
+		if extreme.Type != want[i] {
+			t.Errorf("event %d: expected %s, got %s", i, want[i], extreme.Type)
+		}
+	}
+}
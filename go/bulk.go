@@ -0,0 +1,183 @@
+package tabuamare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// defaultMaxConcurrency é o número padrão de requisições simultâneas em operações em lote
+const defaultMaxConcurrency = 4
+
+// WithMaxConcurrency define quantas requisições simultâneas as operações em lote
+// (GetTidesBetween, GetTideTableForYear, GetTideTablesForYear) podem fazer.
+// O limite de requisições configurado no cliente continua valendo para todas elas.
+func WithMaxConcurrency(n int) ClientOption {
+	return func(c *Client) {
+		if n < 1 {
+			n = 1
+		}
+		c.maxConcurrency = n
+	}
+}
+
+// MonthFailure identifica um mês de um porto que não pôde ser obtido
+type MonthFailure struct {
+	HarborID int
+	Month    int
+	Err      error
+}
+
+// BulkError agrega as falhas de uma operação em lote que retornou resultados parciais
+type BulkError struct {
+	Failures []MonthFailure
+}
+
+func (e *BulkError) Error() string {
+	parts := make([]string, len(e.Failures))
+	for i, failure := range e.Failures {
+		parts[i] = fmt.Sprintf("harbor %d month %d: %v", failure.HarborID, failure.Month, failure.Err)
+	}
+	return fmt.Sprintf("%d harbor/month requests failed: %s", len(e.Failures), strings.Join(parts, "; "))
+}
+
+// Unwrap retorna os erros individuais, permitindo o uso de errors.Is e errors.As
+func (e *BulkError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, failure := range e.Failures {
+		errs[i] = failure.Err
+	}
+	return errs
+}
+
+// GetTideTableForYear retorna a tábua de marés de um porto para os 12 meses do ano.
+// Se alguns meses falharem, retorna os meses obtidos junto com um *BulkError.
+func (c *Client) GetTideTableForYear(ctx context.Context, harborID int) (*TideTable, error) {
+	tables, err := c.GetTideTablesForYear(ctx, harborID)
+	if table, ok := tables[harborID]; ok {
+		return table, err
+	}
+	if err == nil {
+		err = ErrEmptyResponse
+	}
+	return nil, err
+}
+
+// GetTideTablesForYear retorna as tábuas anuais de vários portos, indexadas pelo ID.
+// As requisições mensais passam por um pool limitado por WithMaxConcurrency; se
+// algumas falharem, os resultados parciais são retornados junto com um *BulkError.
+func (c *Client) GetTideTablesForYear(ctx context.Context, harborIDs ...int) (map[int]*TideTable, error) {
+	if len(harborIDs) == 0 {
+		return nil, &ValidationError{Field: "harborIDs", Message: "at least one harbor ID is required", Err: ErrInvalidHarborID}
+	}
+	for _, id := range harborIDs {
+		if id <= 0 {
+			return nil, &ValidationError{Field: "harborIDs", Message: "harbor IDs must be positive integers", Err: ErrInvalidHarborID}
+		}
+	}
+
+	windows := make([]monthWindow, 12)
+	for i := range windows {
+		windows[i] = monthWindow{month: i + 1, days: make([]int, 31)}
+		for day := range windows[i].days {
+			windows[i].days[day] = day + 1
+		}
+	}
+
+	type job struct {
+		harborID int
+		month    int
+	}
+	jobs := make([]job, 0, len(harborIDs)*12)
+	seen := make(map[int]bool, len(harborIDs))
+	for _, id := range harborIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		for month := 1; month <= 12; month++ {
+			jobs = append(jobs, job{harborID: id, month: month})
+		}
+	}
+
+	var mu sync.Mutex
+	monthly := make(map[int][][]TideTable, len(harborIDs))
+	var failures []MonthFailure
+
+	c.runPool(len(jobs), func(i int) {
+		j := jobs[i]
+		tables, err := c.GetTideTableForMonth(ctx, j.harborID, j.month)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			failures = append(failures, MonthFailure{HarborID: j.harborID, Month: j.month, Err: err})
+			return
+		}
+		if monthly[j.harborID] == nil {
+			monthly[j.harborID] = make([][]TideTable, 12)
+		}
+		monthly[j.harborID][j.month-1] = tables
+	})
+
+	results := make(map[int]*TideTable, len(monthly))
+	for id, tables := range monthly {
+		if table, err := mergeTideTables(windows, tables); err == nil {
+			results[id] = table
+		}
+	}
+
+	if len(failures) > 0 {
+		sort.Slice(failures, func(a, b int) bool {
+			if failures[a].HarborID != failures[b].HarborID {
+				return failures[a].HarborID < failures[b].HarborID
+			}
+			return failures[a].Month < failures[b].Month
+		})
+		return results, &BulkError{Failures: failures}
+	}
+
+	return results, nil
+}
+
+// runPool executa fn para cada índice de 0 a n-1 com no máximo maxConcurrency chamadas simultâneas
+func (c *Client) runPool(n int, fn func(i int)) {
+	workers := min(c.maxConcurrency, n)
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+// firstError retorna o primeiro erro de uma execução em paralelo, preferindo a causa
+// original aos context.Canceled provocados pelo cancelamento das demais chamadas
+func firstError(errs []error) error {
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			return err
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
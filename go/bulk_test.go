@@ -0,0 +1,86 @@
+package tabuamare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetTideTablesForYear_PartialResults(t *testing.T) {
+	var inFlight, peak int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			old := atomic.LoadInt32(&peak)
+			if current <= old || atomic.CompareAndSwapInt32(&peak, old, current) {
+				break
+			}
+		}
+		time.Sleep(2 * time.Millisecond)
+
+		parts := strings.Split(r.URL.Path, "/")
+		harborID, _ := strconv.Atoi(parts[2])
+		month, _ := strconv.Atoi(parts[3])
+
+		if harborID == 2 && (month == 3 || month == 7) {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte("boom"))
+			return
+		}
+
+		response := TideTableResponse{
+			Data: []TideTable{{
+				Year:     2025,
+				Timezone: "UTC -03.0",
+				Months: []TideMonth{{
+					Month: month,
+					Days:  []TideDay{{Day: 1, Hours: []TideHour{{Hour: "06:00:00", Level: 1.5}}}},
+				}},
+			}},
+			Total: 1,
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithMaxConcurrency(3))
+	tables, err := client.GetTideTablesForYear(context.Background(), 1, 2)
+
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) {
+		t.Fatalf("expected BulkError, got %v", err)
+	}
+	if len(bulkErr.Failures) != 2 || bulkErr.Failures[0].Month != 3 || bulkErr.Failures[1].Month != 7 {
+		t.Errorf("unexpected failures: %+v", bulkErr.Failures)
+	}
+	if !IsAPIError(err) {
+		t.Error("expected the aggregated error to unwrap to the APIError")
+	}
+
+	if len(tables[1].Months) != 12 {
+		t.Errorf("expected 12 months for harbor 1, got %d", len(tables[1].Months))
+	}
+	if len(tables[2].Months) != 10 {
+		t.Errorf("expected 10 months for harbor 2, got %d", len(tables[2].Months))
+	}
+	if got := atomic.LoadInt32(&peak); got > 3 {
+		t.Errorf("expected at most 3 concurrent requests, got %d", got)
+	}
+}
+
+func TestGetTideTableForYear_InvalidHarbor(t *testing.T) {
+	client := NewClient()
+
+	var valErr *ValidationError
+	if _, err := client.GetTideTableForYear(context.Background(), 0); !errors.As(err, &valErr) {
+		t.Errorf("expected ValidationError, got %v", err)
+	}
+}
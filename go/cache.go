@@ -0,0 +1,207 @@
+package tabuamare
+
+import (
+	"bytes"
+	"container/list"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultCacheTTL      = time.Hour
+	defaultCacheCapacity = 1024
+)
+
+// defaultCacheTTLs define o tempo de vida padrão das respostas por prefixo de rota
+var defaultCacheTTLs = map[string]time.Duration{
+	"/states":                            7 * 24 * time.Hour,
+	"/harbor_names/":                     7 * 24 * time.Hour,
+	"/harbors/":                          24 * time.Hour,
+	"/tabua-mare/":                       24 * time.Hour,
+	"/nearest-harbor-independent-state/": 24 * time.Hour,
+}
+
+// Cache armazena corpos de respostas da API.
+// Implementações devem ser seguras para uso concorrente.
+type Cache interface {
+	// Get retorna o valor armazenado para key, se existir e não tiver expirado
+	Get(key string) ([]byte, bool)
+	// Set armazena value para key; ttl <= 0 indica que o valor não expira
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// WithCache habilita o cache de respostas de requisições GET bem-sucedidas.
+// As chaves são derivadas do caminho da requisição (ex: "/harbors/1,2").
+func WithCache(cache Cache) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithCacheTTL define o tempo de vida das respostas das rotas que começam com prefix
+// (ex: "/tabua-mare/"). Um ttl <= 0 desabilita o cache dessas rotas.
+func WithCacheTTL(prefix string, ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cacheTTLs[prefix] = ttl
+	}
+}
+
+// CacheMiddleware responde requisições GET com os corpos guardados em cache e guarda
+// as respostas bem-sucedidas. O tempo de vida segue WithCacheTTL nos clientes do SDK.
+func CacheMiddleware(cache Cache) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet {
+				return next.Do(req)
+			}
+
+			key := requestPath(req)
+			ttl := cacheTTLFor(defaultCacheTTLs, key)
+			info, ok := RequestInfoFromContext(req.Context())
+			if ok {
+				ttl = info.cacheTTL
+			}
+			if ttl <= 0 {
+				return next.Do(req)
+			}
+
+			if body, hit := cache.Get(key); hit {
+				if ok {
+					info.CacheHit = true
+				}
+				return cachedResponse(req, body), nil
+			}
+
+			resp, err := next.Do(req)
+			if err != nil || resp.StatusCode < 200 || resp.StatusCode >= 300 {
+				return resp, err
+			}
+
+			body, err := readBody(resp)
+			if err != nil {
+				return nil, err
+			}
+			cache.Set(key, body, ttl)
+			return resp, nil
+		})
+	}
+}
+
+// cachedResponse monta a resposta de uma requisição atendida pelo cache
+func cachedResponse(req *http.Request, body []byte) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// cacheTTL retorna o tempo de vida configurado para o caminho
+func (c *Client) cacheTTL(path string) time.Duration {
+	return cacheTTLFor(c.cacheTTLs, path)
+}
+
+// cacheTTLFor retorna o tempo de vida de ttls para o caminho, usando o prefixo mais longo
+func cacheTTLFor(ttls map[string]time.Duration, path string) time.Duration {
+	ttl := defaultCacheTTL
+	matched := -1
+	for prefix, prefixTTL := range ttls {
+		if strings.HasPrefix(path, prefix) && len(prefix) > matched {
+			ttl = prefixTTL
+			matched = len(prefix)
+		}
+	}
+	return ttl
+}
+
+// MemoryCache é um Cache em memória com política de remoção LRU
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+type memoryCacheEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemoryCache cria um cache em memória que mantém até capacity entradas
+func NewMemoryCache(capacity int) *MemoryCache {
+	if capacity <= 0 {
+		capacity = defaultCacheCapacity
+	}
+
+	return &MemoryCache{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get retorna o valor armazenado para key, se existir e não tiver expirado
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*memoryCacheEntry)
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		m.order.Remove(elem)
+		delete(m.items, key)
+		return nil, false
+	}
+
+	m.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Set armazena value para key, removendo a entrada menos usada se o cache estiver cheio
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := &memoryCacheEntry{
+		key:   key,
+		value: append([]byte(nil), value...),
+	}
+	if ttl > 0 {
+		entry.expiresAt = time.Now().Add(ttl)
+	}
+
+	if elem, ok := m.items[key]; ok {
+		elem.Value = entry
+		m.order.MoveToFront(elem)
+		return
+	}
+
+	m.items[key] = m.order.PushFront(entry)
+	for m.order.Len() > m.capacity {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.items, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// Len retorna o número de entradas armazenadas, incluindo as já expiradas
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.order.Len()
+}
@@ -0,0 +1,130 @@
+package tabuamare
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", []byte("1"), 0)
+	cache.Set("b", []byte("2"), 0)
+
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatal("expected key a to be cached")
+	}
+
+	cache.Set("c", []byte("3"), 0)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("expected key b to be evicted")
+	}
+	if value, ok := cache.Get("a"); !ok || string(value) != "1" {
+		t.Errorf("expected key a to survive eviction, got %q", value)
+	}
+	if cache.Len() != 2 {
+		t.Errorf("expected 2 entries, got %d", cache.Len())
+	}
+}
+
+func TestMemoryCache_Expiration(t *testing.T) {
+	cache := NewMemoryCache(10)
+	cache.Set("a", []byte("1"), 10*time.Millisecond)
+
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatal("expected key a to be cached")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	if _, ok := cache.Get("a"); ok {
+		t.Error("expected key a to be expired")
+	}
+}
+
+func TestFileCache_RoundTrip(t *testing.T) {
+	cache, err := NewFileCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	cache.Set("/harbors/1", []byte(`{"data": []}`), time.Hour)
+	cache.Set("/states", []byte(`{}`), -time.Second)
+	cache.Set("/expired", []byte(`{}`), time.Nanosecond)
+	time.Sleep(time.Millisecond)
+
+	if value, ok := cache.Get("/harbors/1"); !ok || string(value) != `{"data": []}` {
+		t.Errorf("expected cached value, got %q (found: %v)", value, ok)
+	}
+	if _, ok := cache.Get("/states"); !ok {
+		t.Error("expected entry without expiration to be cached")
+	}
+	if _, ok := cache.Get("/expired"); ok {
+		t.Error("expected expired entry to be missing")
+	}
+	if _, ok := cache.Get("/missing"); ok {
+		t.Error("expected missing entry to be missing")
+	}
+}
+
+func TestWithCache_ServesRepeatedRequests(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data": ["sc"], "total": 1}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithBaseURL(server.URL),
+		WithCache(NewMemoryCache(10)),
+		WithCacheTTL("/tabua-mare/", 0),
+	)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetStates(ctx); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected 1 call for cached route, got %d", got)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.doRequest(ctx, "GET", "/tabua-mare/1/1/%5B1%5D"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Errorf("expected route with disabled cache to hit the server, got %d calls", got)
+	}
+}
+
+func TestCacheTTL_LongestPrefixWins(t *testing.T) {
+	client := NewClient(
+		WithCacheTTL("/harbors/", time.Minute),
+		WithCacheTTL("/harbors/1", time.Second),
+	)
+
+	testCases := []struct {
+		path string
+		want time.Duration
+	}{
+		{"/states", defaultCacheTTLs["/states"]},
+		{"/harbors/2", time.Minute},
+		{"/harbors/1,2", time.Second},
+		{"/unknown", defaultCacheTTL},
+	}
+
+	for _, tc := range testCases {
+		if got := client.cacheTTL(tc.path); got != tc.want {
+			t.Errorf("cacheTTL(%q): expected %s, got %s", tc.path, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package tabuamare
+
+import (
+	"fmt"
+	"time"
+)
+
+// leapYear é um ano bissexto qualquer, usado quando o ano dos dados ainda não é conhecido
+const leapYear = 2024
+
+// DaysInMonth retorna a quantidade de dias do mês, considerando anos bissextos
+func DaysInMonth(year, month int) int {
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// NewDayRangeForMonth cria um DayRange validando os dias contra o calendário do mês,
+// rejeitando datas inexistentes como 30 de fevereiro ou 29 de fevereiro fora de anos bissextos
+func NewDayRangeForMonth(year, month int, days ...int) (*DayRange, error) {
+	if month < 1 || month > 12 {
+		return nil, ErrInvalidMonth
+	}
+
+	if len(days) == 0 {
+		return nil, &ValidationError{Field: "days", Message: "at least one day is required", Err: ErrInvalidDays}
+	}
+
+	last := DaysInMonth(year, month)
+	for _, day := range days {
+		if day < 1 || day > last {
+			return nil, &ValidationError{
+				Field:   "days",
+				Message: fmt.Sprintf("day %d does not exist in %04d-%02d", day, year, month),
+				Err:     ErrInvalidDays,
+			}
+		}
+	}
+
+	return NewDayRange(days...)
+}
+
+// DayRangeThisWeek retorna os dias da semana (domingo a sábado) que contém t,
+// limitados ao mês de t
+func DayRangeThisWeek(t time.Time) (*DayRange, error) {
+	year, month := t.Year(), int(t.Month())
+	sunday := t.Day() - int(t.Weekday())
+
+	start := max(sunday, 1)
+	end := min(sunday+6, DaysInMonth(year, month))
+
+	return NewDayRangeFromInterval(start, end)
+}
+
+// DayRangeRestOfMonth retorna os dias de t até o fim do mês de t
+func DayRangeRestOfMonth(t time.Time) (*DayRange, error) {
+	return NewDayRangeFromInterval(t.Day(), DaysInMonth(t.Year(), int(t.Month())))
+}
+
+// DayRangeWeekdays retorna todos os dias do mês que caem nos dias da semana informados
+// (ex: todos os sábados de março)
+func DayRangeWeekdays(year, month int, weekdays ...time.Weekday) (*DayRange, error) {
+	if month < 1 || month > 12 {
+		return nil, ErrInvalidMonth
+	}
+
+	if len(weekdays) == 0 {
+		return nil, &ValidationError{Field: "weekdays", Message: "at least one weekday is required", Err: ErrInvalidDays}
+	}
+
+	wanted := make(map[time.Weekday]bool, len(weekdays))
+	for _, weekday := range weekdays {
+		wanted[weekday] = true
+	}
+
+	var days []int
+	for _, day := range monthDays(year, month) {
+		if wanted[time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC).Weekday()] {
+			days = append(days, day)
+		}
+	}
+
+	return NewDayRange(days...)
+}
+
+// monthDays retorna todos os dias do mês, de 1 até o último
+func monthDays(year, month int) []int {
+	days := make([]int, DaysInMonth(year, month))
+	for i := range days {
+		days[i] = i + 1
+	}
+	return days
+}
@@ -0,0 +1,133 @@
+package tabuamare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDaysInMonth(t *testing.T) {
+	testCases := []struct {
+		year, month, want int
+	}{
+		{2025, 1, 31},
+		{2025, 2, 28},
+		{2024, 2, 29},
+		{1900, 2, 28},
+		{2000, 2, 29},
+		{2025, 4, 30},
+		{2025, 12, 31},
+	}
+
+	for _, tc := range testCases {
+		if got := DaysInMonth(tc.year, tc.month); got != tc.want {
+			t.Errorf("DaysInMonth(%d, %d): expected %d, got %d", tc.year, tc.month, tc.want, got)
+		}
+	}
+}
+
+func TestNewDayRangeForMonth(t *testing.T) {
+	if _, err := NewDayRangeForMonth(2024, 2, 1, 29); err != nil {
+		t.Errorf("expected February 29 to be valid in 2024, got %v", err)
+	}
+
+	var valErr *ValidationError
+	if _, err := NewDayRangeForMonth(2025, 2, 29); !errors.As(err, &valErr) {
+		t.Errorf("expected ValidationError for February 29 2025, got %v", err)
+	}
+	if _, err := NewDayRangeForMonth(2025, 4, 31); !errors.As(err, &valErr) {
+		t.Errorf("expected ValidationError for April 31, got %v", err)
+	}
+	if _, err := NewDayRangeForMonth(2025, 13, 1); err != ErrInvalidMonth {
+		t.Errorf("expected ErrInvalidMonth, got %v", err)
+	}
+}
+
+func TestDayRangeHelpers(t *testing.T) {
+	// 2025-03-01 é um sábado e 2025-03-04 uma terça-feira
+	firstSaturday := time.Date(2025, time.March, 1, 10, 0, 0, 0, time.UTC)
+	tuesday := time.Date(2025, time.March, 4, 10, 0, 0, 0, time.UTC)
+	// 2025-03-30 é um domingo
+	lastSunday := time.Date(2025, time.March, 30, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name  string
+		build func() (*DayRange, error)
+		want  []int
+	}{
+		{"this week", func() (*DayRange, error) { return DayRangeThisWeek(tuesday) }, []int{2, 3, 4, 5, 6, 7, 8}},
+		{"this week clipped to month start", func() (*DayRange, error) { return DayRangeThisWeek(firstSaturday) }, []int{1}},
+		{"this week clipped to month end", func() (*DayRange, error) { return DayRangeThisWeek(lastSunday) }, []int{30, 31}},
+		{"rest of month", func() (*DayRange, error) { return DayRangeRestOfMonth(lastSunday) }, []int{30, 31}},
+		{"saturdays in march", func() (*DayRange, error) { return DayRangeWeekdays(2025, 3, time.Saturday) }, []int{1, 8, 15, 22, 29}},
+		{"weekends in february", func() (*DayRange, error) { return DayRangeWeekdays(2025, 2, time.Saturday, time.Sunday) }, []int{1, 2, 8, 9, 15, 16, 22, 23}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dayRange, err := tc.build()
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(dayRange.days) != len(tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, dayRange.days)
+			}
+			for i := range tc.want {
+				if dayRange.days[i] != tc.want[i] {
+					t.Fatalf("expected %v, got %v", tc.want, dayRange.days)
+				}
+			}
+		})
+	}
+}
+
+func TestGetTideTableForMonth_UsesMonthLength(t *testing.T) {
+	testCases := []struct {
+		year  int
+		month int
+		path  string
+		want  int
+	}{
+		{2024, 2, "/tabua-mare/1/2/[1-29]", 29},
+		{2025, 2, "/tabua-mare/1/2/[1-29]", 28},
+		{2025, 4, "/tabua-mare/1/4/[1-30]", 30},
+		{2025, 1, "/tabua-mare/1/1/[1-31]", 31},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("%d-%02d", tc.year, tc.month), func(t *testing.T) {
+			var requested string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requested = r.URL.Path
+				days := make([]TideDay, DaysInMonth(2024, tc.month))
+				for i := range days {
+					days[i] = TideDay{Day: i + 1}
+				}
+				_ = json.NewEncoder(w).Encode(TideTableResponse{Data: []TideTable{{
+					Year:   tc.year,
+					Months: []TideMonth{{Month: tc.month, Days: days}},
+				}}})
+			}))
+			defer server.Close()
+
+			client := NewClient(WithBaseURL(server.URL))
+			tables, err := client.GetTideTableForMonth(context.Background(), 1, tc.month)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if requested != tc.path {
+				t.Errorf("expected %s, got %s", tc.path, requested)
+			}
+			if got := len(tables[0].Months[0].Days); got != tc.want {
+				t.Errorf("expected %d days, got %d", tc.want, got)
+			}
+		})
+	}
+}
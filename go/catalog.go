@@ -0,0 +1,238 @@
+package tabuamare
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// catalogChunkSize é a quantidade de IDs enviada em cada chamada a GetHarbors durante LoadCatalog
+const catalogChunkSize = 20
+
+// CatalogEntry reúne os detalhes de um porto com as informações da listagem por estado
+type CatalogEntry struct {
+	Harbor
+	Year                      int    `json:"year"`
+	DataCollectionInstitution string `json:"data_collection_institution"`
+}
+
+// Catalog é o catálogo completo de portos, indexado por ID, estado, nome e instituição
+// responsável pela coleta dos dados. É imutável e seguro para uso concorrente.
+// Serializa para JSON como uma lista de CatalogEntry, e os índices são reconstruídos
+// ao desserializar.
+type Catalog struct {
+	entries       []CatalogEntry
+	byID          map[int]int
+	byState       map[string][]int
+	byName        map[string]int
+	byInstitution map[string][]int
+}
+
+// NewCatalog cria um catálogo a partir das entradas informadas, ordenadas por ID.
+// Entradas com ID repetido mantêm apenas a primeira ocorrência.
+func NewCatalog(entries []CatalogEntry) *Catalog {
+	catalog := &Catalog{
+		entries:       make([]CatalogEntry, 0, len(entries)),
+		byID:          make(map[int]int, len(entries)),
+		byState:       make(map[string][]int),
+		byName:        make(map[string]int, len(entries)),
+		byInstitution: make(map[string][]int),
+	}
+
+	seen := make(map[int]bool, len(entries))
+	for _, entry := range entries {
+		if seen[entry.ID] {
+			continue
+		}
+		seen[entry.ID] = true
+		catalog.entries = append(catalog.entries, entry)
+	}
+
+	sort.SliceStable(catalog.entries, func(i, j int) bool {
+		return catalog.entries[i].ID < catalog.entries[j].ID
+	})
+
+	for i, entry := range catalog.entries {
+		catalog.byID[entry.ID] = i
+		state := catalogKey(entry.State)
+		catalog.byState[state] = append(catalog.byState[state], i)
+		if name := catalogKey(entry.HarborName); name != "" {
+			if _, ok := catalog.byName[name]; !ok {
+				catalog.byName[name] = i
+			}
+		}
+		institution := catalogKey(entry.DataCollectionInstitution)
+		catalog.byInstitution[institution] = append(catalog.byInstitution[institution], i)
+	}
+
+	return catalog
+}
+
+// Len retorna o número de portos no catálogo
+func (c *Catalog) Len() int {
+	return len(c.entries)
+}
+
+// Entries retorna todas as entradas do catálogo, ordenadas por ID
+func (c *Catalog) Entries() []CatalogEntry {
+	return append([]CatalogEntry(nil), c.entries...)
+}
+
+// Harbors retorna os detalhes de todos os portos, por exemplo para criar um HarborIndex
+func (c *Catalog) Harbors() []Harbor {
+	harbors := make([]Harbor, len(c.entries))
+	for i, entry := range c.entries {
+		harbors[i] = entry.Harbor
+	}
+	return harbors
+}
+
+// ByID retorna o porto com o ID informado
+func (c *Catalog) ByID(id int) (*CatalogEntry, bool) {
+	i, ok := c.byID[id]
+	if !ok {
+		return nil, false
+	}
+	entry := c.entries[i]
+	return &entry, true
+}
+
+// ByName retorna o porto com o nome informado, sem diferenciar maiúsculas e minúsculas
+func (c *Catalog) ByName(name string) (*CatalogEntry, bool) {
+	i, ok := c.byName[catalogKey(name)]
+	if !ok {
+		return nil, false
+	}
+	entry := c.entries[i]
+	return &entry, true
+}
+
+// ByState retorna os portos de um estado (ex: "pe"), ordenados por ID
+func (c *Catalog) ByState(state string) []CatalogEntry {
+	return c.collect(c.byState[catalogKey(state)])
+}
+
+// ByInstitution retorna os portos cujos dados são coletados pela instituição informada
+func (c *Catalog) ByInstitution(institution string) []CatalogEntry {
+	return c.collect(c.byInstitution[catalogKey(institution)])
+}
+
+// States retorna as siglas dos estados presentes no catálogo, em ordem alfabética
+func (c *Catalog) States() []string {
+	states := make([]string, 0, len(c.byState))
+	for state := range c.byState {
+		states = append(states, state)
+	}
+	sort.Strings(states)
+	return states
+}
+
+// MarshalJSON serializa o catálogo como uma lista de CatalogEntry
+func (c *Catalog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.entries)
+}
+
+// UnmarshalJSON carrega o catálogo a partir de uma lista de CatalogEntry e reconstrói os índices
+func (c *Catalog) UnmarshalJSON(data []byte) error {
+	var entries []CatalogEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	*c = *NewCatalog(entries)
+	return nil
+}
+
+func (c *Catalog) collect(indexes []int) []CatalogEntry {
+	entries := make([]CatalogEntry, len(indexes))
+	for i, index := range indexes {
+		entries[i] = c.entries[index]
+	}
+	return entries
+}
+
+// catalogKey normaliza as chaves dos índices do catálogo
+func catalogKey(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// LoadCatalog monta o catálogo completo de portos: lista os estados, consulta os portos
+// de cada estado em paralelo e busca os detalhes em lotes de IDs. A concorrência é
+// limitada por WithMaxConcurrency e a primeira falha interrompe a carga. Estados
+// retornados pela API e ainda desconhecidos pelo SDK também são carregados.
+func (c *Client) LoadCatalog(ctx context.Context) (*Catalog, error) {
+	states, err := c.GetStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	names := make([][]HarborName, len(states))
+	errs := make([]error, len(states))
+	c.runPool(len(states), func(i int) {
+		names[i], errs[i] = c.fetchHarborNames(ctx, states[i])
+		if errs[i] != nil {
+			cancel()
+		}
+	})
+	if err := firstError(errs); err != nil {
+		return nil, err
+	}
+
+	var entries []CatalogEntry
+	seen := make(map[int]bool)
+	for i, state := range states {
+		for _, name := range names[i] {
+			if seen[name.ID] {
+				continue
+			}
+			seen[name.ID] = true
+			entries = append(entries, CatalogEntry{
+				Harbor:                    Harbor{ID: name.ID, HarborName: name.HarborName, State: strings.ToLower(state)},
+				Year:                      name.Year,
+				DataCollectionInstitution: name.DataCollectionInstitution,
+			})
+		}
+	}
+
+	chunks := (len(entries) + catalogChunkSize - 1) / catalogChunkSize
+	details := make([][]Harbor, chunks)
+	errs = make([]error, chunks)
+	c.runPool(chunks, func(i int) {
+		start := i * catalogChunkSize
+		end := min(start+catalogChunkSize, len(entries))
+
+		ids := make([]int, 0, end-start)
+		for _, entry := range entries[start:end] {
+			ids = append(ids, entry.ID)
+		}
+
+		details[i], errs[i] = c.GetHarbors(ctx, ids...)
+		if errs[i] != nil {
+			cancel()
+		}
+	})
+	if err := firstError(errs); err != nil {
+		return nil, err
+	}
+
+	harbors := make(map[int]Harbor, len(entries))
+	for _, chunk := range details {
+		for _, harbor := range chunk {
+			harbors[harbor.ID] = harbor
+		}
+	}
+	for i := range entries {
+		// portos sem detalhes na resposta mantêm os dados básicos da listagem
+		if harbor, ok := harbors[entries[i].ID]; ok {
+			if harbor.State == "" {
+				harbor.State = entries[i].State
+			}
+			entries[i].Harbor = harbor
+		}
+	}
+
+	return NewCatalog(entries), nil
+}
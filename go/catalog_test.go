@@ -0,0 +1,143 @@
+package tabuamare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// newCatalogServer simula as rotas de estados, portos por estado e detalhes de portos.
+// Pernambuco tem 25 portos para exigir mais de um lote de GetHarbors.
+func newCatalogServer(t *testing.T, harborRequests *[]string, mu *sync.Mutex) *httptest.Server {
+	names := map[string][]HarborName{
+		"al": {{ID: 1, Year: 2025, HarborName: "PORTO DE MACEIÓ", DataCollectionInstitution: "Marinha do Brasil"}},
+		"pe": {},
+		// estado novo na API, ainda desconhecido por ParseState
+		"zz": {{ID: 200, Year: 2025, HarborName: "PORTO NOVO", DataCollectionInstitution: "CHM"}},
+	}
+	for id := 100; id < 125; id++ {
+		names["pe"] = append(names["pe"], HarborName{
+			ID:                        id,
+			Year:                      2025,
+			HarborName:                fmt.Sprintf("PORTO %d", id),
+			DataCollectionInstitution: "CHM",
+		})
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/states":
+			_ = json.NewEncoder(w).Encode(StatesResponse{Data: []string{"al", "pe", "zz"}, Total: 3})
+
+		case strings.HasPrefix(r.URL.Path, "/harbor_names/"):
+			state := strings.TrimPrefix(r.URL.Path, "/harbor_names/")
+			_ = json.NewEncoder(w).Encode(HarborNamesResponse{Data: names[state], Total: len(names[state])})
+
+		case strings.HasPrefix(r.URL.Path, "/harbors/"):
+			mu.Lock()
+			*harborRequests = append(*harborRequests, r.URL.Path)
+			mu.Unlock()
+
+			var harbors []Harbor
+			for _, raw := range strings.Split(strings.TrimPrefix(r.URL.Path, "/harbors/"), ",") {
+				id, err := strconv.Atoi(raw)
+				if err != nil {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				state := "pe"
+				switch id {
+				case 1:
+					state = "al"
+				case 200:
+					state = "zz"
+				}
+				harbors = append(harbors, testHarbor(id, fmt.Sprintf("PORTO %d", id), state, -8, -35))
+			}
+			_ = json.NewEncoder(w).Encode(HarborsResponse{Data: harbors, Total: len(harbors)})
+
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+}
+
+func TestLoadCatalog(t *testing.T) {
+	var mu sync.Mutex
+	var harborRequests []string
+	server := newCatalogServer(t, &harborRequests, &mu)
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	catalog, err := client.LoadCatalog(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if catalog.Len() != 27 {
+		t.Fatalf("expected 27 harbors, got %d", catalog.Len())
+	}
+	if len(harborRequests) != 2 {
+		t.Errorf("expected harbor details in 2 chunks, got %d requests", len(harborRequests))
+	}
+
+	entry, ok := catalog.ByID(1)
+	if !ok || entry.State != "al" || entry.Year != 2025 || entry.DataCollectionInstitution != "Marinha do Brasil" {
+		t.Errorf("unexpected entry for ID 1: %+v", entry)
+	}
+	if len(entry.GeoLocation) != 1 {
+		t.Error("expected harbor details to be merged into the entry")
+	}
+
+	if got := len(catalog.ByState("PE")); got != 25 {
+		t.Errorf("expected 25 harbors in PE, got %d", got)
+	}
+	if got := len(catalog.ByInstitution("chm")); got != 26 {
+		t.Errorf("expected 26 harbors from CHM, got %d", got)
+	}
+	if entry, ok := catalog.ByName("porto 110"); !ok || entry.ID != 110 {
+		t.Errorf("expected lookup by name to find ID 110, got %+v", entry)
+	}
+	if states := catalog.States(); len(states) != 3 || states[0] != "al" || states[2] != "zz" {
+		t.Errorf("expected states [al pe zz], got %v", states)
+	}
+}
+
+func TestCatalog_JSONRoundTrip(t *testing.T) {
+	catalog := NewCatalog([]CatalogEntry{
+		{Harbor: testHarbor(3, "PORTO DE SANTOS", "sp", -23.95, -46.333333), Year: 2025, DataCollectionInstitution: "CHM"},
+		{Harbor: testHarbor(1, "PORTO DE MACEIÓ", "al", -9.683333, -35.716667), Year: 2025, DataCollectionInstitution: "CHM"},
+		{Harbor: testHarbor(1, "DUPLICADO", "al", 0, 0)},
+	})
+	if catalog.Len() != 2 || catalog.Entries()[0].ID != 1 {
+		t.Fatalf("expected 2 entries sorted by ID, got %+v", catalog.Entries())
+	}
+
+	data, err := json.Marshal(catalog)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var loaded Catalog
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if loaded.Len() != 2 {
+		t.Fatalf("expected 2 entries, got %d", loaded.Len())
+	}
+	if entry, ok := loaded.ByID(3); !ok || entry.HarborName != "PORTO DE SANTOS" {
+		t.Errorf("expected Santos after reload, got %+v", entry)
+	}
+	if got := len(loaded.ByInstitution("CHM")); got != 2 {
+		t.Errorf("expected indexes to be rebuilt, got %d harbors for CHM", got)
+	}
+	if idx := NewHarborIndex(loaded.Harbors()); idx.Len() != 2 {
+		t.Errorf("expected the catalog to feed a HarborIndex, got %d harbors", idx.Len())
+	}
+}
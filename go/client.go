@@ -2,9 +2,8 @@ package tabuamare
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
+	"log/slog"
 	"net/http"
 	"strings"
 	"time"
@@ -17,8 +16,19 @@ const (
 
 // Client é o cliente HTTP para a API Tide Table
 type Client struct {
-	baseURL    string
-	httpClient *http.Client
+	baseURL     string
+	httpClient  *http.Client
+	retryPolicy *RetryPolicy
+	rateLimiter *RateLimiter
+	cache       Cache
+	cacheTTLs   map[string]time.Duration
+	inflight    flightGroup
+	harborIndex *HarborIndex
+	middlewares []Middleware
+	doer        Doer
+	logger      *slog.Logger
+
+	maxConcurrency int
 }
 
 // ClientOption é uma função que configura o Client
@@ -52,53 +62,60 @@ func NewClient(opts ...ClientOption) *Client {
 		httpClient: &http.Client{
 			Timeout: defaultTimeout,
 		},
+		cacheTTLs:      make(map[string]time.Duration, len(defaultCacheTTLs)),
+		maxConcurrency: defaultMaxConcurrency,
+	}
+
+	for prefix, ttl := range defaultCacheTTLs {
+		client.cacheTTLs[prefix] = ttl
 	}
 
 	for _, opt := range opts {
 		opt(client)
 	}
 
+	client.doer = client.buildChain()
+
 	return client
 }
 
-// doRequest executa uma requisição HTTP
+// doRequest executa uma requisição HTTP pela cadeia de middlewares
 func (c *Client) doRequest(ctx context.Context, method, path string) ([]byte, error) {
-	url := fmt.Sprintf("%s%s", c.baseURL, path)
+	info := &RequestInfo{Method: method, Path: path, Attempt: 1, cacheTTL: c.cacheTTL(path), logger: c.logger}
+	ctx = context.WithValue(ctx, requestInfoKey{}, info)
 
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+	start := time.Now()
+	body, status, err := c.send(ctx, method, path)
+	if c.logger != nil {
+		c.logRequest(ctx, info, c.baseURL+path, status, body, time.Since(start), err)
 	}
 
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, &NetworkError{Err: err}
+		return nil, &RequestError{Method: method, Path: path, Attempt: info.Attempt, Err: err}
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	return body, nil
+}
+
+// send monta a requisição, executa-a pela cadeia e converte respostas de erro.
+// O corpo e o status da resposta são retornados também em caso de erro, se houver.
+func (c *Client) send(ctx context.Context, method, path string) ([]byte, int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	if resp.StatusCode == http.StatusTooManyRequests {
-		return nil, ErrRateLimitExceeded
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.doer.Do(req)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	if resp.StatusCode >= 400 {
-		var apiErr APIError
-		if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Message != "" {
-			apiErr.Status = resp.StatusCode
-			return nil, &apiErr
-		}
-		return nil, &APIError{
-			Status:  resp.StatusCode,
-			Code:    resp.StatusCode,
-			Message: string(body),
-		}
+	body, err := readBody(resp)
+	if err != nil {
+		return nil, resp.StatusCode, err
 	}
 
-	return body, nil
+	return body, resp.StatusCode, responseError(resp, body)
 }
@@ -2,6 +2,7 @@ package tabuamare
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -63,7 +64,7 @@ func TestDoRequest_RateLimitExceeded(t *testing.T) {
 
 	client := NewClient(WithBaseURL(server.URL))
 	_, err := client.doRequest(context.Background(), "GET", "/test")
-	if err != ErrRateLimitExceeded {
+	if !errors.Is(err, ErrRateLimitExceeded) {
 		t.Errorf("expected ErrRateLimitExceeded, got %v", err)
 	}
 }
@@ -0,0 +1,73 @@
+package tabuamare
+
+import (
+	"context"
+	"time"
+)
+
+// maxCurveSamples limita o número de amostras geradas por uma curva
+const maxCurveSamples = 100000
+
+// Curve retorna amostras do nível da maré igualmente espaçadas por step, de from até to
+// (inclusive, quando to coincide com uma amostra)
+func (p *TidePredictor) Curve(from, to time.Time, step time.Duration) ([]TideReading, error) {
+	if step <= 0 {
+		return nil, &ValidationError{Field: "step", Message: "step must be positive"}
+	}
+	if to.Before(from) {
+		return nil, &ValidationError{Field: "to", Message: "to must not be before from"}
+	}
+	if to.Sub(from)/step >= maxCurveSamples {
+		return nil, &ValidationError{Field: "step", Message: "too many samples for the requested range"}
+	}
+
+	samples := make([]TideReading, 0, to.Sub(from)/step+1)
+	for t := from; !t.After(to); t = t.Add(step) {
+		reading, err := p.At(t)
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, reading)
+	}
+
+	return samples, nil
+}
+
+// GetTideCurve retorna a curva de maré de um porto entre from e to, buscando
+// automaticamente as tábuas de todos os meses necessários
+func (c *Client) GetTideCurve(ctx context.Context, harborID int, from, to time.Time, step time.Duration, method InterpolationMethod) ([]TideReading, error) {
+	if harborID <= 0 {
+		return nil, &ValidationError{Field: "harborID", Message: "harbor ID must be a positive integer", Err: ErrInvalidHarborID}
+	}
+	if to.Before(from) {
+		return nil, &ValidationError{Field: "to", Message: "to must not be before from"}
+	}
+
+	// Um dia de margem garante os extremos anteriores a from e posteriores a to, sem
+	// sair do ano civil de from e de to, já que a API serve um único ano de dados
+	start := from.Add(-24 * time.Hour)
+	if yearStart := time.Date(from.Year(), time.January, 1, 0, 0, 0, 0, from.Location()); start.Before(yearStart) {
+		start = yearStart
+	}
+	end := to.Add(24 * time.Hour)
+	if yearEnd := time.Date(to.Year(), time.December, 31, 0, 0, 0, 0, to.Location()); end.After(yearEnd) {
+		end = yearEnd
+	}
+
+	table, err := c.GetTidesBetween(ctx, harborID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := table.Events()
+	if err != nil {
+		return nil, err
+	}
+
+	predictor, err := NewTidePredictor(events, method)
+	if err != nil {
+		return nil, err
+	}
+
+	return predictor.Curve(from, to, step)
+}
@@ -0,0 +1,154 @@
+package tabuamare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTidePredictor_Curve(t *testing.T) {
+	events := eventsFromLevels(0.2, 2.2, 0.2)
+	predictor, err := NewTidePredictor(events, InterpolationCosine)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	samples, err := predictor.Curve(events[0].Time, events[2].Time, time.Hour)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(samples) != 13 {
+		t.Fatalf("expected 13 samples, got %d", len(samples))
+	}
+	if samples[6].Level != 2.2 || !samples[12].Time.Equal(events[2].Time) {
+		t.Errorf("unexpected samples: %+v, %+v", samples[6], samples[12])
+	}
+
+	if _, err := predictor.Curve(events[0].Time, events[2].Time.Add(time.Hour), time.Hour); !errors.Is(err, ErrTimeOutOfRange) {
+		t.Errorf("expected ErrTimeOutOfRange, got %v", err)
+	}
+
+	var valErr *ValidationError
+	if _, err := predictor.Curve(events[0].Time, events[2].Time, 0); !errors.As(err, &valErr) {
+		t.Errorf("expected ValidationError, got %v", err)
+	}
+}
+
+func TestGetTideCurve_SpansMonths(t *testing.T) {
+	var mu sync.Mutex
+	var months []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		month := strings.Split(r.URL.Path, "/")[3]
+		mu.Lock()
+		months = append(months, month)
+		mu.Unlock()
+		monthNumber, _ := strconv.Atoi(month)
+
+		day := TideDay{Day: 31, Hours: []TideHour{{Hour: "06:00:00", Level: 2.0}, {Hour: "18:00:00", Level: 0.4}}}
+		if month == "2" {
+			day = TideDay{Day: 1, Hours: []TideHour{{Hour: "06:00:00", Level: 2.1}, {Hour: "18:00:00", Level: 0.3}}}
+		}
+
+		response := TideTableResponse{
+			Data: []TideTable{{
+				Year:     2025,
+				Timezone: "UTC -03.0",
+				Months:   []TideMonth{{Month: monthNumber, Days: []TideDay{day}}},
+			}},
+			Total: 1,
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	zone := time.FixedZone("UTC-3", -3*3600)
+	from := time.Date(2025, time.January, 31, 12, 0, 0, 0, zone)
+	to := time.Date(2025, time.February, 1, 12, 0, 0, 0, zone)
+
+	samples, err := client.GetTideCurve(context.Background(), 1, from, to, 30*time.Minute, InterpolationCosine)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	sort.Strings(months)
+	if len(months) != 2 || months[0] != "1" || months[1] != "2" {
+		t.Errorf("expected requests for months 1 and 2, got %v", months)
+	}
+	if len(samples) != 49 {
+		t.Errorf("expected 49 samples, got %d", len(samples))
+	}
+	if samples[12].Level != 0.4 || samples[36].Level != 2.1 {
+		t.Errorf("expected samples at the extremes, got %f and %f", samples[12].Level, samples[36].Level)
+	}
+}
+
+func TestGetTideCurve_EdgesOfDataYear(t *testing.T) {
+	var mu sync.Mutex
+	requested := make(map[string]bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		month := strings.Split(r.URL.Path, "/")[3]
+		mu.Lock()
+		requested[month] = true
+		mu.Unlock()
+		monthNumber, _ := strconv.Atoi(month)
+
+		hours := []TideHour{{Hour: "00:30:00", Level: 0.3}, {Hour: "06:45:00", Level: 2.1}, {Hour: "12:50:00", Level: 0.4}, {Hour: "19:00:00", Level: 2.0}}
+		var days []TideDay
+		for day := 1; day <= DaysInMonth(2025, monthNumber); day++ {
+			days = append(days, TideDay{Day: day, Hours: hours})
+		}
+
+		_ = json.NewEncoder(w).Encode(TideTableResponse{
+			Data: []TideTable{{
+				Year:     2025,
+				Timezone: "UTC -03.0",
+				Months:   []TideMonth{{Month: monthNumber, Days: days}},
+			}},
+			Total: 1,
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	zone := time.FixedZone("UTC-3", -3*3600)
+
+	testCases := []struct {
+		name  string
+		from  time.Time
+		month string
+	}{
+		{"starts on January 1", time.Date(2025, time.January, 1, 6, 0, 0, 0, zone), "1"},
+		{"ends on December 31", time.Date(2025, time.December, 31, 6, 0, 0, 0, zone), "12"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mu.Lock()
+			requested = make(map[string]bool)
+			mu.Unlock()
+
+			samples, err := client.GetTideCurve(context.Background(), 1, tc.from, tc.from.Add(12*time.Hour), time.Hour, InterpolationCosine)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(samples) != 13 {
+				t.Errorf("expected 13 samples, got %d", len(samples))
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if len(requested) != 1 || !requested[tc.month] {
+				t.Errorf("expected only month %s to be requested, got %v", tc.month, requested)
+			}
+		})
+	}
+}
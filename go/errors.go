@@ -1,8 +1,10 @@
 package tabuamare
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
 )
 
 var (
@@ -26,6 +28,24 @@ var (
 
 	// ErrInvalidCoordinates é retornado quando as coordenadas geográficas são inválidas
 	ErrInvalidCoordinates = errors.New("invalid coordinates")
+
+	// ErrInvalidTideHour é retornado quando o horário de uma maré não está no formato HH:MM:SS
+	ErrInvalidTideHour = errors.New("invalid tide hour")
+
+	// ErrInvalidTimezone é retornado quando o fuso horário não pode ser interpretado
+	ErrInvalidTimezone = errors.New("invalid timezone")
+
+	// ErrTimeOutOfRange é retornado quando o instante está fora do intervalo coberto pelas marés
+	ErrTimeOutOfRange = errors.New("time outside the covered tide interval")
+
+	// ErrBadRequest é envolvido por APIError quando a API responde 400
+	ErrBadRequest = errors.New("bad request")
+
+	// ErrNotFound é envolvido por APIError quando a API responde 404
+	ErrNotFound = errors.New("not found")
+
+	// ErrServerError é envolvido por APIError quando a API responde com status 5xx
+	ErrServerError = errors.New("server error")
 )
 
 // APIError representa um erro retornado pela API
@@ -39,13 +59,35 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("API error (status %d, code %d): %s", e.Status, e.Code, e.Message)
 }
 
+// Unwrap retorna o erro sentinela correspondente ao status (ErrBadRequest, ErrNotFound,
+// ErrRateLimitExceeded ou ErrServerError), permitindo errors.Is(err, ErrNotFound)
+func (e *APIError) Unwrap() error {
+	status := e.Status
+	if status == 0 {
+		status = e.Code
+	}
+
+	switch {
+	case status == http.StatusBadRequest:
+		return ErrBadRequest
+	case status == http.StatusNotFound:
+		return ErrNotFound
+	case status == http.StatusTooManyRequests:
+		return ErrRateLimitExceeded
+	case status >= 500 && status <= 599:
+		return ErrServerError
+	}
+	return nil
+}
+
 // IsAPIError verifica se um erro é do tipo APIError
 func IsAPIError(err error) bool {
 	var apiErr *APIError
 	return errors.As(err, &apiErr)
 }
 
-// NetworkError representa um erro de rede
+// NetworkError representa um erro de rede. Erros do transporte que informam
+// Permanent() == true não são considerados erros de rede e são retornados como estão.
 type NetworkError struct {
 	Err error
 }
@@ -58,12 +100,78 @@ func (e *NetworkError) Unwrap() error {
 	return e.Err
 }
 
-// ValidationError representa um erro de validação de parâmetros
+// IsNotFound verifica se o erro corresponde a um recurso inexistente (404)
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsRetryable verifica se a falha é transitória e a mesma requisição pode ser repetida:
+// erros de rede, limite de requisições (429) e os status 500, 502, 503 e 504
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var netErr *NetworkError
+	if errors.As(err, &netErr) || errors.Is(err, ErrRateLimitExceeded) {
+		return true
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.Status {
+		case http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		}
+	}
+	return false
+}
+
+// IsTemporary verifica se a falha tende a desaparecer sozinha, mesmo que repetir a
+// requisição imediatamente não seja recomendado: além dos casos de IsRetryable,
+// inclui os demais status 5xx (exceto 501) e o tempo esgotado
+func IsTemporary(err error) bool {
+	if IsRetryable(err) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && errors.Is(apiErr, ErrServerError) && apiErr.Status != http.StatusNotImplemented
+}
+
+// RequestError identifica a requisição que falhou, com o método, o caminho e o número
+// de tentativas feitas. Envolve o erro original, que continua acessível por errors.Is e errors.As.
+type RequestError struct {
+	Method  string
+	Path    string
+	Attempt int
+	Err     error
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("%s %s (attempt %d): %v", e.Method, e.Path, e.Attempt, e.Err)
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError representa um erro de validação de parâmetros. Quando há um erro
+// sentinela correspondente (ex: ErrInvalidHarborID, ErrInvalidDays), ele fica em Err
+// e pode ser verificado com errors.Is.
 type ValidationError struct {
 	Field   string
 	Message string
+	Err     error
 }
 
 func (e *ValidationError) Error() string {
 	return fmt.Sprintf("validation error on field '%s': %s", e.Field, e.Message)
 }
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
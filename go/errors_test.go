@@ -0,0 +1,100 @@
+package tabuamare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIError_WrapsStatusSentinel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code": 404, "msg": "harbor not found"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	_, err := client.GetHarbors(context.Background(), 999)
+
+	if !IsNotFound(err) || !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if IsRetryable(err) || IsTemporary(err) {
+		t.Errorf("expected a 404 not to be retryable or temporary")
+	}
+
+	var reqErr *RequestError
+	if !errors.As(err, &reqErr) {
+		t.Fatalf("expected RequestError, got %T", err)
+	}
+	if reqErr.Method != http.MethodGet || reqErr.Path != "/harbors/999" || reqErr.Attempt != 1 {
+		t.Errorf("unexpected request details: %+v", reqErr)
+	}
+	if !IsAPIError(err) {
+		t.Error("expected the APIError to remain accessible")
+	}
+}
+
+func TestErrorClassifiers(t *testing.T) {
+	testCases := []struct {
+		name      string
+		err       error
+		retryable bool
+		temporary bool
+	}{
+		{"nil", nil, false, false},
+		{"network", &NetworkError{Err: errors.New("connection reset")}, true, true},
+		{"rate limit", ErrRateLimitExceeded, true, true},
+		{"wrapped 503", &RequestError{Err: &APIError{Status: http.StatusServiceUnavailable}}, true, true},
+		{"507", &APIError{Status: http.StatusInsufficientStorage}, false, true},
+		{"501", &APIError{Status: http.StatusNotImplemented}, false, false},
+		{"400", &APIError{Status: http.StatusBadRequest}, false, false},
+		{"deadline", fmt.Errorf("waiting: %w", context.DeadlineExceeded), false, true},
+		{"canceled", &NetworkError{Err: context.Canceled}, false, false},
+		{"validation", &ValidationError{Field: "ids", Err: ErrInvalidHarborID}, false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsRetryable(tc.err); got != tc.retryable {
+				t.Errorf("IsRetryable: expected %v, got %v", tc.retryable, got)
+			}
+			if got := IsTemporary(tc.err); got != tc.temporary {
+				t.Errorf("IsTemporary: expected %v, got %v", tc.temporary, got)
+			}
+		})
+	}
+
+	if !errors.Is(&APIError{Code: 500}, ErrServerError) {
+		t.Error("expected the code to be used when the status is missing")
+	}
+	if !errors.Is(&APIError{Status: http.StatusBadRequest}, ErrBadRequest) {
+		t.Error("expected ErrBadRequest for a 400")
+	}
+}
+
+func TestValidationError_WrapsSentinels(t *testing.T) {
+	client := NewClient()
+	ctx := context.Background()
+
+	if _, err := client.GetTideTable(ctx, 0, 1, []int{1}); !errors.Is(err, ErrInvalidHarborID) {
+		t.Errorf("expected ErrInvalidHarborID, got %v", err)
+	}
+	if _, err := client.GetHarbors(ctx, -1); !errors.Is(err, ErrInvalidHarborID) {
+		t.Errorf("expected ErrInvalidHarborID, got %v", err)
+	}
+	if _, err := NewDayRange(32); !errors.Is(err, ErrInvalidDays) {
+		t.Errorf("expected ErrInvalidDays, got %v", err)
+	}
+	if _, err := client.GetNearestHarbor(ctx, 91, 0); !errors.Is(err, ErrInvalidCoordinates) {
+		t.Errorf("expected ErrInvalidCoordinates, got %v", err)
+	}
+
+	var valErr *ValidationError
+	if _, err := client.GetHarborNames(ctx, ""); !errors.As(err, &valErr) || !errors.Is(err, ErrInvalidState) {
+		t.Errorf("expected a ValidationError wrapping ErrInvalidState, got %v", err)
+	}
+}
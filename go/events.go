@@ -0,0 +1,80 @@
+package tabuamare
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TideEvent representa uma maré em um instante exato
+type TideEvent struct {
+	Time  time.Time `json:"time"`
+	Level float64   `json:"level"`
+}
+
+// Events retorna as marés da tábua em ordem cronológica, com os horários
+// resolvidos no fuso horário do porto. Todos os meses pertencem ao ano da tábua.
+func (t TideTable) Events() ([]TideEvent, error) {
+	fixed, err := ParseTimezone(t.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := t.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	var events []TideEvent
+	year := t.Year
+	for _, month := range t.Months {
+		for _, day := range month.Days {
+			date := time.Date(year, time.Month(month.Month), day.Day, 0, 0, 0, 0, fixed)
+			if date.Month() != time.Month(month.Month) || date.Day() != day.Day {
+				return nil, fmt.Errorf("invalid tide date %04d-%02d-%02d", year, month.Month, day.Day)
+			}
+
+			for _, hour := range day.Hours {
+				offset, err := parseTideHour(hour.Hour)
+				if err != nil {
+					return nil, fmt.Errorf("%w %q on %s", ErrInvalidTideHour, hour.Hour, date.Format("2006-01-02"))
+				}
+
+				events = append(events, TideEvent{
+					Time:  date.Add(offset).In(loc),
+					Level: hour.Level,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.Before(events[j].Time)
+	})
+
+	return events, nil
+}
+
+// parseTideHour converte um horário "HH:MM:SS" ou "HH:MM" no tempo decorrido desde a meia-noite
+func parseTideHour(hour string) (time.Duration, error) {
+	parts := strings.Split(strings.TrimSpace(hour), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, ErrInvalidTideHour
+	}
+
+	limits := []int{23, 59, 59}
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+
+	var offset time.Duration
+	for i, part := range parts {
+		value, err := strconv.Atoi(part)
+		if err != nil || len(part) != 2 || value < 0 || value > limits[i] {
+			return 0, ErrInvalidTideHour
+		}
+		offset += time.Duration(value) * units[i]
+	}
+
+	return offset, nil
+}
@@ -0,0 +1,90 @@
+package tabuamare
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func sampleTideTable() TideTable {
+	return TideTable{
+		Year:       2025,
+		HarborName: "PORTO DE MACEIÓ (ESTADO DE ALAGOAS)",
+		State:      "al",
+		Timezone:   "UTC -03.0",
+		MeanLevel:  1.16,
+		Months: []TideMonth{
+			{
+				MonthName: "January",
+				Month:     1,
+				Days: []TideDay{
+					{
+						WeekdayName: "friday",
+						Day:         3,
+						Hours: []TideHour{
+							{Hour: "06:01:00", Level: 1.87},
+							{Hour: "12:04:00", Level: 0.35},
+							{Hour: "18:14:00", Level: 1.99},
+						},
+					},
+					{
+						WeekdayName: "saturday",
+						Day:         4,
+						Hours: []TideHour{
+							{Hour: "00:21:00", Level: 0.28},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestTideTable_Events(t *testing.T) {
+	events, err := sampleTideTable().Events()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(events) != 4 {
+		t.Fatalf("expected 4 events, got %d", len(events))
+	}
+
+	want := time.Date(2025, time.January, 3, 9, 1, 0, 0, time.UTC)
+	if !events[0].Time.Equal(want) {
+		t.Errorf("expected first event at %s, got %s", want, events[0].Time.UTC())
+	}
+	if _, offset := events[0].Time.Zone(); offset != -3*3600 {
+		t.Errorf("expected offset -3h, got %ds", offset)
+	}
+	if events[3].Level != 0.28 || events[3].Time.Day() != 4 {
+		t.Errorf("unexpected last event: %+v", events[3])
+	}
+
+	for i := 1; i < len(events); i++ {
+		if !events[i].Time.After(events[i-1].Time) {
+			t.Errorf("expected events in chronological order, got %s after %s", events[i].Time, events[i-1].Time)
+		}
+	}
+}
+
+func TestTideTable_EventsInvalidHour(t *testing.T) {
+	for _, hour := range []string{"6:01", "25:00:00", "12:60:00", "meio-dia", ""} {
+		table := sampleTideTable()
+		table.Months[0].Days[0].Hours[1].Hour = hour
+
+		_, err := table.Events()
+		if !errors.Is(err, ErrInvalidTideHour) {
+			t.Errorf("hour %q: expected ErrInvalidTideHour, got %v", hour, err)
+		}
+	}
+}
+
+func TestTideTable_EventsInvalidTimezone(t *testing.T) {
+	table := sampleTideTable()
+	table.Timezone = "horário de Brasília"
+
+	if _, err := table.Events(); !errors.Is(err, ErrInvalidTimezone) {
+		t.Errorf("expected ErrInvalidTimezone, got %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package tabuamare
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileCache é um Cache persistido em disco, com um arquivo por chave.
+// Falhas de leitura ou escrita são tratadas como ausência da entrada no cache.
+type FileCache struct {
+	dir string
+}
+
+type fileCacheEntry struct {
+	Key       string    `json:"key"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Data      []byte    `json:"data"`
+}
+
+// NewFileCache cria um cache que armazena as respostas no diretório dir
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	return &FileCache{dir: dir}, nil
+}
+
+// Get retorna o valor armazenado para key, se existir e não tiver expirado
+func (f *FileCache) Get(key string) ([]byte, bool) {
+	path := f.filename(key)
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	var entry fileCacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil || entry.Key != key {
+		return nil, false
+	}
+
+	if !entry.ExpiresAt.IsZero() && time.Now().After(entry.ExpiresAt) {
+		_ = os.Remove(path)
+		return nil, false
+	}
+
+	return entry.Data, true
+}
+
+// Set armazena value para key; a escrita é atômica para leitores concorrentes
+func (f *FileCache) Set(key string, value []byte, ttl time.Duration) {
+	entry := fileCacheEntry{Key: key, Data: value}
+	if ttl > 0 {
+		entry.ExpiresAt = time.Now().Add(ttl)
+	}
+
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+
+	_, err = tmp.Write(raw)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return
+	}
+
+	if err := os.Rename(tmp.Name(), f.filename(key)); err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
+
+// filename retorna o arquivo que guarda a entrada de key
+func (f *FileCache) filename(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,86 @@
+package tabuamare
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// flightGroup compartilha o resultado de requisições idênticas em andamento
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+// flightCall é uma requisição em andamento e os chamadores que aguardam por ela
+type flightCall struct {
+	done    chan struct{}
+	body    []byte
+	resp    *http.Response
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+	info    *RequestInfo
+}
+
+// do executa fn uma única vez para chamadas concorrentes com a mesma key.
+// A requisição compartilhada não é cancelada pelo contexto de um chamador
+// específico, apenas quando todos os chamadores desistem dela.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(context.Context) ([]byte, *http.Response, error)) ([]byte, *http.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+
+	call, ok := g.calls[key]
+	if !ok {
+		// a requisição compartilhada registra suas informações em uma cópia, para não
+		// alterar as do chamador que a iniciou depois que ele desistir
+		info := &RequestInfo{}
+		if callerInfo, ok := RequestInfoFromContext(ctx); ok {
+			*info = *callerInfo
+			info.RateLimitWait = 0
+		}
+		callCtx, cancel := context.WithCancel(context.WithValue(context.WithoutCancel(ctx), requestInfoKey{}, info))
+		call = &flightCall{done: make(chan struct{}), cancel: cancel, info: info}
+		g.calls[key] = call
+
+		go func() {
+			call.body, call.resp, call.err = fn(callCtx)
+
+			g.mu.Lock()
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+			g.mu.Unlock()
+
+			cancel()
+			close(call.done)
+		}()
+	}
+	call.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		if info, ok := RequestInfoFromContext(ctx); ok {
+			info.RateLimitWait += call.info.RateLimitWait
+		}
+		return call.body, call.resp, call.err
+	case <-ctx.Done():
+		g.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			call.cancel()
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+		}
+		g.mu.Unlock()
+		return nil, nil, ctx.Err()
+	}
+}
@@ -0,0 +1,183 @@
+package tabuamare
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDoRequest_CoalescesConcurrentCalls(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data": ["sc"], "total": 1}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			states, err := client.GetStates(context.Background())
+			if err != nil {
+				t.Errorf("expected no error, got %v", err)
+				return
+			}
+			if len(states) != 1 || states[0] != "sc" {
+				t.Errorf("unexpected states: %v", states)
+			}
+		}()
+	}
+
+	waitForWaiters(t, &client.inflight, 5)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
+
+func TestDoRequest_CoalescesBeforeRateLimit(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		_, _ = w.Write([]byte(`{"data": ["sc"], "total": 1}`))
+	}))
+	defer server.Close()
+
+	// com uma cota por minuto, só chamadores que compartilham a requisição terminam a tempo
+	client := NewClient(WithBaseURL(server.URL), WithRateLimit(1, time.Minute))
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+	var once sync.Once
+	defer once.Do(func() { close(release) })
+
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetStates(ctx); err != nil && ctx.Err() == nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		}()
+	}
+
+	waitForWaiters(t, &client.inflight, 5)
+	once.Do(func() { close(release) })
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected the callers to share 1 request and 1 token, got %d requests", got)
+	}
+}
+
+func TestDoRequest_CoalescedCallerCancellation(t *testing.T) {
+	var calls int32
+	entered := make(chan struct{}, 1)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		entered <- struct{}{}
+		<-release
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data": ["sc"], "total": 1}`))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClient(WithBaseURL(server.URL))
+
+	patient := make(chan error, 1)
+	go func() {
+		_, err := client.GetStates(context.Background())
+		patient <- err
+	}()
+
+	<-entered
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetStates(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	release <- struct{}{}
+	if err := <-patient; err != nil {
+		t.Errorf("expected the remaining caller to succeed, got %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
+
+func TestFlightGroup_CancelsWhenAllCallersLeave(t *testing.T) {
+	var group flightGroup
+	started := make(chan struct{})
+	stopped := make(chan error, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	_, _, err := group.do(ctx, "GET /test", func(callCtx context.Context) ([]byte, *http.Response, error) {
+		close(started)
+		<-callCtx.Done()
+		stopped <- callCtx.Err()
+		return nil, nil, callCtx.Err()
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+
+	select {
+	case err := <-stopped:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected shared request to be canceled, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected shared request to be canceled")
+	}
+}
+
+// waitForWaiters aguarda até que n chamadores estejam registrados nas requisições
+// compartilhadas de group
+func waitForWaiters(t *testing.T, group *flightGroup, n int) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		group.mu.Lock()
+		waiters := 0
+		for _, call := range group.calls {
+			waiters += call.waiters
+		}
+		group.mu.Unlock()
+
+		if waiters >= n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d waiters, got %d", n, waiters)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
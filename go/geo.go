@@ -0,0 +1,177 @@
+package tabuamare
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// coordinateTolerance é a diferença máxima, em graus, aceita entre as representações
+// decimal e em graus/minutos de uma mesma coordenada (1 minuto de arco)
+const coordinateTolerance = 1.0 / 60
+
+// compassPoints são as 16 direções da rosa dos ventos, a partir do norte no sentido horário
+var compassPoints = [...]string{
+	"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE",
+	"S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW",
+}
+
+var (
+	dmsMinuteDecimal = regexp.MustCompile(`(\d+)'\.(\d+)`)
+	dmsPattern       = regexp.MustCompile(`^([+-]?\d+(?:\.\d+)?)\s*°?\s*(?:(\d+(?:\.\d+)?)\s*'\s*)?(?:(\d+(?:\.\d+)?)\s*"\s*)?([NSEWLO])?$`)
+)
+
+// CoordinateMismatchError indica que as representações decimal e em graus/minutos
+// de uma coordenada divergem além da tolerância
+type CoordinateMismatchError struct {
+	Field   string // "lat" ou "lng"
+	Decimal float64
+	DMS     float64
+}
+
+func (e *CoordinateMismatchError) Error() string {
+	return fmt.Sprintf("coordinate mismatch on %s: decimal %.6f differs from DMS %.6f by %.4f°",
+		e.Field, e.Decimal, e.DMS, math.Abs(e.Decimal-e.DMS))
+}
+
+// LatLng retorna a latitude e a longitude em graus decimais. Os campos Lat e Lng
+// são usados quando válidos; caso contrário, DecimalLat e DecimalLng são
+// interpretados como graus/minutos/segundos. Se as duas representações
+// divergirem, as coordenadas decimais são retornadas junto com um
+// *CoordinateMismatchError.
+func (g GeoLocation) LatLng() (float64, float64, error) {
+	lat, err := resolveCoordinate("lat", g.Lat, g.DecimalLat, g.LatDirection, 90)
+	if err != nil && !isCoordinateMismatch(err) {
+		return 0, 0, err
+	}
+
+	lng, lngErr := resolveCoordinate("lng", g.Lng, g.DecimalLng, g.LngDirection, 180)
+	if lngErr != nil && !isCoordinateMismatch(lngErr) {
+		return 0, 0, lngErr
+	}
+
+	return lat, lng, errors.Join(err, lngErr)
+}
+
+// Coordinates retorna a latitude e a longitude da primeira localização do porto
+func (h Harbor) Coordinates() (float64, float64, error) {
+	if len(h.GeoLocation) == 0 {
+		return 0, 0, fmt.Errorf("%w: harbor %d has no geo location", ErrInvalidCoordinates, h.ID)
+	}
+	return h.GeoLocation[0].LatLng()
+}
+
+// ParseDMS converte uma coordenada em graus, minutos e segundos (ex: "35° 43'.5 W",
+// "09° 41' S" ou "23°57'30\"S") em graus decimais. Os hemisférios S e W (ou O)
+// resultam em valores negativos.
+func ParseDMS(s string) (float64, error) {
+	value, hemisphere, err := parseDMS(s)
+	if err != nil {
+		return 0, err
+	}
+	return applyHemisphere(value, hemisphere), nil
+}
+
+// InitialBearing retorna o rumo inicial, em graus de 0 a 360 a partir do norte, do
+// ponto (lat1, lng1) até o ponto (lat2, lng2) pelo círculo máximo
+func InitialBearing(lat1, lng1, lat2, lng2 float64) float64 {
+	phi1, phi2 := lat1*math.Pi/180, lat2*math.Pi/180
+	dLambda := (lng2 - lng1) * math.Pi / 180
+
+	y := math.Sin(dLambda) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(dLambda)
+
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+}
+
+// CompassDirection converte um rumo em graus na direção da rosa dos ventos de 16 pontos (ex: "NNE")
+func CompassDirection(bearing float64) string {
+	normalized := math.Mod(math.Mod(bearing, 360)+360, 360)
+	return compassPoints[int(math.Round(normalized/22.5))%len(compassPoints)]
+}
+
+// resolveCoordinate escolhe entre a representação decimal e a DMS de uma coordenada
+func resolveCoordinate(field, decimal, dms, direction string, limit float64) (float64, error) {
+	decValue, decErr := strconv.ParseFloat(strings.TrimSpace(decimal), 64)
+	if decErr == nil {
+		decValue = applyHemisphere(decValue, direction)
+		if math.IsNaN(decValue) || math.Abs(decValue) > limit {
+			decErr = ErrInvalidCoordinates
+		}
+	}
+
+	dmsValue, hemisphere, dmsErr := parseDMS(dms)
+	if dmsErr == nil {
+		if hemisphere == "" {
+			hemisphere = direction
+		}
+		dmsValue = applyHemisphere(dmsValue, hemisphere)
+		if math.Abs(dmsValue) > limit {
+			dmsErr = ErrInvalidCoordinates
+		}
+	}
+
+	switch {
+	case decErr == nil && dmsErr == nil:
+		if math.Abs(decValue-dmsValue) > coordinateTolerance {
+			return decValue, &CoordinateMismatchError{Field: field, Decimal: decValue, DMS: dmsValue}
+		}
+		return decValue, nil
+	case decErr == nil:
+		return decValue, nil
+	case dmsErr == nil:
+		return dmsValue, nil
+	}
+
+	return 0, fmt.Errorf("%w: %s %q / %q", ErrInvalidCoordinates, field, decimal, dms)
+}
+
+// parseDMS interpreta uma coordenada DMS e retorna o valor absoluto e o hemisfério, se presente
+func parseDMS(s string) (float64, string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(s))
+	normalized = strings.NewReplacer("º", "°", "˚", "°", "′", "'", "’", "'", "″", `"`, "''", `"`, ",", ".").Replace(normalized)
+	normalized = dmsMinuteDecimal.ReplaceAllString(normalized, "$1.$2'")
+
+	match := dmsPattern.FindStringSubmatch(normalized)
+	if match == nil {
+		return 0, "", fmt.Errorf("%w: %q", ErrInvalidCoordinates, s)
+	}
+
+	degrees, _ := strconv.ParseFloat(match[1], 64)
+	var minutes, seconds float64
+	if match[2] != "" {
+		minutes, _ = strconv.ParseFloat(match[2], 64)
+	}
+	if match[3] != "" {
+		seconds, _ = strconv.ParseFloat(match[3], 64)
+	}
+	if minutes >= 60 || seconds >= 60 {
+		return 0, "", fmt.Errorf("%w: %q", ErrInvalidCoordinates, s)
+	}
+
+	value := math.Abs(degrees) + minutes/60 + seconds/3600
+	hemisphere := match[4]
+	if strings.HasPrefix(match[1], "-") {
+		hemisphere = "-"
+	}
+
+	return value, hemisphere, nil
+}
+
+// applyHemisphere torna o valor negativo nos hemisférios sul e oeste
+func applyHemisphere(value float64, hemisphere string) float64 {
+	switch strings.ToUpper(strings.TrimSpace(hemisphere)) {
+	case "S", "W", "O", "-":
+		return -math.Abs(value)
+	}
+	return value
+}
+
+// isCoordinateMismatch informa se err é um *CoordinateMismatchError
+func isCoordinateMismatch(err error) bool {
+	var mismatch *CoordinateMismatchError
+	return errors.As(err, &mismatch)
+}
@@ -0,0 +1,138 @@
+package tabuamare
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseDMS(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  float64
+	}{
+		{"09° 41' S", -(9 + 41.0/60)},
+		{"35° 43'.5 W", -(35 + 43.5/60)},
+		{"23° 57' S", -(23 + 57.0/60)},
+		{"23°57'30\"S", -(23 + 57.0/60 + 30.0/3600)},
+		{"46° 20′ 15″ O", -(46 + 20.0/60 + 15.0/3600)},
+		{"3° 43,2' N", 3 + 43.2/60},
+		{"-8.5", -8.5},
+		{"12°", 12},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseDMS(tc.input)
+		if err != nil {
+			t.Errorf("%q: expected no error, got %v", tc.input, err)
+			continue
+		}
+		if math.Abs(got-tc.want) > 1e-9 {
+			t.Errorf("%q: expected %f, got %f", tc.input, tc.want, got)
+		}
+	}
+}
+
+func TestParseDMS_Invalid(t *testing.T) {
+	for _, input := range []string{"", "norte", "23° 75' S", "23° 57' X"} {
+		if _, err := ParseDMS(input); !errors.Is(err, ErrInvalidCoordinates) {
+			t.Errorf("%q: expected ErrInvalidCoordinates, got %v", input, err)
+		}
+	}
+}
+
+func TestGeoLocation_LatLng(t *testing.T) {
+	geo := GeoLocation{
+		Lat:          "-9.683333333333334",
+		Lng:          "-35.71666666666667",
+		DecimalLat:   "09° 41' S",
+		DecimalLng:   "35° 43'.5 W",
+		LatDirection: "s",
+		LngDirection: "w",
+	}
+
+	lat, lng, err := geo.LatLng()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if lat != -9.683333333333334 || lng != -35.71666666666667 {
+		t.Errorf("expected decimal coordinates, got %f, %f", lat, lng)
+	}
+}
+
+func TestGeoLocation_LatLngFallsBackToDMS(t *testing.T) {
+	geo := GeoLocation{
+		DecimalLat:   "09° 41'",
+		DecimalLng:   "35° 43'.5",
+		LatDirection: "s",
+		LngDirection: "w",
+	}
+
+	lat, lng, err := geo.LatLng()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if math.Abs(lat+9.683333) > 1e-6 || math.Abs(lng+35.725) > 1e-6 {
+		t.Errorf("unexpected coordinates %f, %f", lat, lng)
+	}
+}
+
+func TestGeoLocation_LatLngMismatch(t *testing.T) {
+	geo := GeoLocation{
+		Lat:          "-9.683333333333334",
+		Lng:          "-36.71666666666667",
+		DecimalLat:   "09° 41' S",
+		DecimalLng:   "35° 43'.5 W",
+		LatDirection: "s",
+		LngDirection: "w",
+	}
+
+	lat, lng, err := geo.LatLng()
+	var mismatch *CoordinateMismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("expected CoordinateMismatchError, got %v", err)
+	}
+	if mismatch.Field != "lng" {
+		t.Errorf("expected mismatch on lng, got %s", mismatch.Field)
+	}
+	if lat != -9.683333333333334 || lng != -36.71666666666667 {
+		t.Errorf("expected decimal coordinates alongside the mismatch, got %f, %f", lat, lng)
+	}
+}
+
+func TestHarbor_CoordinatesWithoutLocation(t *testing.T) {
+	_, _, err := Harbor{ID: 1}.Coordinates()
+	if !errors.Is(err, ErrInvalidCoordinates) {
+		t.Errorf("expected ErrInvalidCoordinates, got %v", err)
+	}
+}
+
+func TestInitialBearingAndCompassDirection(t *testing.T) {
+	testCases := []struct {
+		lat2, lng2 float64
+		bearing    float64
+		direction  string
+	}{
+		{1, 0, 0, "N"},
+		{0, 1, 90, "E"},
+		{-1, 0, 180, "S"},
+		{0, -1, 270, "W"},
+	}
+
+	for _, tc := range testCases {
+		got := InitialBearing(0, 0, tc.lat2, tc.lng2)
+		if math.Abs(got-tc.bearing) > 1e-9 {
+			t.Errorf("(%v, %v): expected bearing %v, got %v", tc.lat2, tc.lng2, tc.bearing, got)
+		}
+		if dir := CompassDirection(got); dir != tc.direction {
+			t.Errorf("(%v, %v): expected %s, got %s", tc.lat2, tc.lng2, tc.direction, dir)
+		}
+	}
+
+	if got := CompassDirection(-22.5); got != "NNW" {
+		t.Errorf("expected negative bearings to wrap, got %s", got)
+	}
+	if got := CompassDirection(355); got != "N" {
+		t.Errorf("expected 355° to round to N, got %s", got)
+	}
+}
@@ -0,0 +1,111 @@
+package tabuamare
+
+import (
+	"math"
+	"sort"
+)
+
+// earthRadiusKm é o raio médio da Terra em quilômetros
+const earthRadiusKm = 6371.0088
+
+// HaversineDistance retorna a distância em quilômetros, pelo círculo máximo, entre dois pontos
+func HaversineDistance(lat1, lng1, lat2, lng2 float64) float64 {
+	phi1, phi2 := lat1*math.Pi/180, lat2*math.Pi/180
+	dPhi := (lat2 - lat1) * math.Pi / 180
+	dLambda := (lng2 - lng1) * math.Pi / 180
+
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	return 2 * earthRadiusKm * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// HarborIndex é um índice geográfico local de portos, para consultas sem acesso à rede.
+// É seguro para uso concorrente depois de criado.
+type HarborIndex struct {
+	entries []indexedHarbor
+}
+
+type indexedHarbor struct {
+	harbor Harbor
+	lat    float64
+	lng    float64
+}
+
+// NewHarborIndex cria um índice a partir dos portos informados. Portos sem
+// coordenadas válidas em GeoLocation são ignorados.
+func NewHarborIndex(harbors []Harbor) *HarborIndex {
+	idx := &HarborIndex{entries: make([]indexedHarbor, 0, len(harbors))}
+	for _, harbor := range harbors {
+		lat, lng, err := harbor.Coordinates()
+		if err != nil && !isCoordinateMismatch(err) {
+			continue
+		}
+		idx.entries = append(idx.entries, indexedHarbor{harbor: harbor, lat: lat, lng: lng})
+	}
+	return idx
+}
+
+// Len retorna o número de portos indexados
+func (idx *HarborIndex) Len() int {
+	return len(idx.entries)
+}
+
+// Nearest retorna até k portos mais próximos da coordenada, do mais próximo ao mais distante
+func (idx *HarborIndex) Nearest(lat, lng float64, k int) []NearestHarbor {
+	if k <= 0 {
+		return nil
+	}
+
+	results := idx.byDistance(lat, lng)
+	if len(results) > k {
+		results = results[:k]
+	}
+	return results
+}
+
+// Within retorna os portos a até radiusKm quilômetros da coordenada, do mais próximo ao mais distante
+func (idx *HarborIndex) Within(lat, lng, radiusKm float64) []NearestHarbor {
+	results := idx.byDistance(lat, lng)
+	n := sort.Search(len(results), func(i int) bool {
+		return results[i].Distance > radiusKm
+	})
+	return results[:n]
+}
+
+// InBoundingBox retorna os portos dentro do retângulo delimitado pelas coordenadas.
+// Se minLng for maior que maxLng, o retângulo cruza o antimeridiano.
+func (idx *HarborIndex) InBoundingBox(minLat, minLng, maxLat, maxLng float64) []Harbor {
+	var harbors []Harbor
+	for _, entry := range idx.entries {
+		if entry.lat < minLat || entry.lat > maxLat {
+			continue
+		}
+
+		inLng := entry.lng >= minLng && entry.lng <= maxLng
+		if minLng > maxLng {
+			inLng = entry.lng >= minLng || entry.lng <= maxLng
+		}
+		if inLng {
+			harbors = append(harbors, entry.harbor)
+		}
+	}
+	return harbors
+}
+
+// byDistance retorna todos os portos indexados ordenados pela distância até a coordenada
+func (idx *HarborIndex) byDistance(lat, lng float64) []NearestHarbor {
+	results := make([]NearestHarbor, len(idx.entries))
+	for i, entry := range idx.entries {
+		bearing := InitialBearing(lat, lng, entry.lat, entry.lng)
+		results[i] = NearestHarbor{
+			Harbor:    entry.harbor,
+			Distance:  HaversineDistance(lat, lng, entry.lat, entry.lng),
+			Bearing:   bearing,
+			Direction: CompassDirection(bearing),
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Distance < results[j].Distance
+	})
+	return results
+}
@@ -0,0 +1,122 @@
+package tabuamare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func testHarbor(id int, name, state string, lat, lng float64) Harbor {
+	return Harbor{
+		ID:         id,
+		HarborName: name,
+		State:      state,
+		Timezone:   "UTC -03.0",
+		GeoLocation: []GeoLocation{{
+			Lat: fmt.Sprintf("%f", lat),
+			Lng: fmt.Sprintf("%f", lng),
+		}},
+	}
+}
+
+func testHarbors() []Harbor {
+	return []Harbor{
+		testHarbor(1, "PORTO DE MACEIÓ (ESTADO DE ALAGOAS)", "al", -9.683333, -35.716667),
+		testHarbor(2, "PORTO DO RECIFE (ESTADO DE PERNAMBUCO)", "pe", -8.05, -34.866667),
+		testHarbor(3, "PORTO DE SANTOS (ESTADO DE SÃO PAULO)", "sp", -23.95, -46.333333),
+		testHarbor(4, "PORTO DE RIO GRANDE (ESTADO DO RIO GRANDE DO SUL)", "rs", -32.033333, -52.1),
+		{ID: 5, HarborName: "PORTO SEM COORDENADAS"},
+	}
+}
+
+func TestHaversineDistance(t *testing.T) {
+	if got := HaversineDistance(0, 0, 0, 1); math.Abs(got-111.195) > 0.01 {
+		t.Errorf("expected one degree of longitude at the equator to be ~111.195 km, got %f", got)
+	}
+	if got := HaversineDistance(-8.05, -34.87, -8.05, -34.87); got != 0 {
+		t.Errorf("expected zero distance, got %f", got)
+	}
+}
+
+func TestHarborIndex_Nearest(t *testing.T) {
+	idx := NewHarborIndex(testHarbors())
+	if idx.Len() != 4 {
+		t.Fatalf("expected 4 indexed harbors, got %d", idx.Len())
+	}
+
+	// João Pessoa fica entre Recife e Maceió, mais perto de Recife
+	results := idx.Nearest(-7.115, -34.863, 2)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if results[0].ID != 2 || results[1].ID != 1 {
+		t.Errorf("expected Recife then Maceió, got %d then %d", results[0].ID, results[1].ID)
+	}
+	if results[0].Distance > results[1].Distance {
+		t.Error("expected results sorted by distance")
+	}
+}
+
+func TestHarborIndex_WithinAndBoundingBox(t *testing.T) {
+	idx := NewHarborIndex(testHarbors())
+
+	within := idx.Within(-8.05, -34.866667, 250)
+	if len(within) != 2 {
+		t.Errorf("expected Recife and Maceió within 250 km, got %d harbors", len(within))
+	}
+
+	south := idx.InBoundingBox(-35, -55, -20, -40)
+	if len(south) != 2 {
+		t.Errorf("expected Santos and Rio Grande in the southern box, got %d harbors", len(south))
+	}
+}
+
+func TestGetNearestHarbor_FallsBackToIndex(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithBaseURL(server.URL),
+		WithHarborIndex(NewHarborIndex(testHarbors())),
+	)
+
+	harbor, err := client.GetNearestHarbor(context.Background(), -23.550520, -46.633308)
+	if err != nil {
+		t.Fatalf("expected fallback to succeed, got %v", err)
+	}
+	if harbor.ID != 3 || !harbor.FromIndex {
+		t.Errorf("expected Santos from the index, got %+v", harbor)
+	}
+	if harbor.Distance < 40 || harbor.Distance > 60 {
+		t.Errorf("expected distance around 50 km, got %f", harbor.Distance)
+	}
+}
+
+func TestGetNearestHarbor_FallbackOnlyForTemporaryErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code": 400, "msg": "invalid coordinates"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithBaseURL(server.URL),
+		WithHarborIndex(NewHarborIndex(testHarbors())),
+	)
+
+	if _, err := client.GetNearestHarbor(context.Background(), -23.550520, -46.633308); !errors.Is(err, ErrBadRequest) {
+		t.Errorf("expected ErrBadRequest, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if harbor, err := client.GetNearestHarbor(ctx, -23.550520, -46.633308); !errors.Is(err, context.Canceled) || harbor != nil {
+		t.Errorf("expected context.Canceled, got %+v (%v)", harbor, err)
+	}
+}
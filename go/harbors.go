@@ -4,18 +4,30 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 )
 
-// GetHarborNames retorna a lista de portos de um estado específico
+// GetHarborNames retorna a lista de portos de um estado específico. O estado é
+// validado localmente com ParseState, retornando ErrInvalidState sem acessar a rede.
 func (c *Client) GetHarborNames(ctx context.Context, state string) ([]HarborName, error) {
 	if state == "" {
-		return nil, &ValidationError{Field: "state", Message: "state cannot be empty"}
+		return nil, &ValidationError{Field: "state", Message: "state cannot be empty", Err: ErrInvalidState}
 	}
 
-	state = strings.ToLower(state)
-	path := fmt.Sprintf("/harbor_names/%s", state)
+	parsed, err := ParseState(state)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.fetchHarborNames(ctx, string(parsed))
+}
+
+// fetchHarborNames consulta os portos de um código de estado sem validá-lo localmente,
+// para aceitar estados retornados pela API que ainda não sejam conhecidos pelo SDK
+func (c *Client) fetchHarborNames(ctx context.Context, state string) ([]HarborName, error) {
+	path := fmt.Sprintf("/harbor_names/%s", url.PathEscape(strings.ToLower(strings.TrimSpace(state))))
 
 	body, err := c.doRequest(ctx, "GET", path)
 	if err != nil {
@@ -37,12 +49,12 @@ func (c *Client) GetHarborNames(ctx context.Context, state string) ([]HarborName
 // GetHarbors retorna informações detalhadas de um ou mais portos por IDs
 func (c *Client) GetHarbors(ctx context.Context, ids ...int) ([]Harbor, error) {
 	if len(ids) == 0 {
-		return nil, &ValidationError{Field: "ids", Message: "at least one harbor ID is required"}
+		return nil, &ValidationError{Field: "ids", Message: "at least one harbor ID is required", Err: ErrInvalidHarborID}
 	}
 
 	for _, id := range ids {
 		if id <= 0 {
-			return nil, &ValidationError{Field: "ids", Message: "harbor IDs must be positive integers"}
+			return nil, &ValidationError{Field: "ids", Message: "harbor IDs must be positive integers", Err: ErrInvalidHarborID}
 		}
 	}
 
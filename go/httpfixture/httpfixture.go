@@ -0,0 +1,348 @@
+// Package httpfixture grava e reproduz interações HTTP em arquivos JSON legíveis,
+// para executar testes de integração sem acesso à rede. O Recorder é um
+// http.RoundTripper e pode ser usado com tabuamare.WithHTTPClient:
+//
+//	rec, err := httpfixture.New("testdata/fixtures", httpfixture.ModeReplay)
+//	client := tabuamare.NewClient(tabuamare.WithHTTPClient(rec.Client()))
+//
+// O pacote não depende do SDK, para poder ser usado pelos testes internos dele.
+package httpfixture
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// redactedValue substitui o valor dos cabeçalhos configurados com WithRedactedHeaders
+const redactedValue = "REDACTED"
+
+// maxNameLength limita o tamanho da parte legível do nome dos arquivos
+const maxNameLength = 80
+
+// ErrNoFixture é retornado no modo replay quando não há gravação para a requisição.
+// Ele informa Permanent() == true, para que clientes HTTP não o tratem como uma
+// falha de rede e não repitam a requisição.
+var ErrNoFixture error = permanentError("no recorded fixture for request")
+
+// permanentError é um erro do transporte que não deve ser repetido
+type permanentError string
+
+func (e permanentError) Error() string {
+	return string(e)
+}
+
+// Permanent indica que repetir a requisição não muda o resultado
+func (permanentError) Permanent() bool {
+	return true
+}
+
+var unsafeNameChars = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// Mode define se o Recorder grava ou reproduz as interações
+type Mode int
+
+const (
+	// ModeReplay responde com as interações gravadas e falha nas demais requisições
+	ModeReplay Mode = iota
+	// ModeRecord executa as requisições reais e grava cada interação em disco
+	ModeRecord
+)
+
+// ParseMode interpreta "replay" ou "record"
+func ParseMode(s string) (Mode, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "replay":
+		return ModeReplay, nil
+	case "record":
+		return ModeRecord, nil
+	}
+	return 0, fmt.Errorf("invalid fixture mode %q: must be record or replay", s)
+}
+
+// Option configura um Recorder
+type Option func(*Recorder)
+
+// WithTransport define o RoundTripper usado no modo record (padrão: http.DefaultTransport)
+func WithTransport(transport http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// WithRedactedHeaders substitui o valor dos cabeçalhos informados por "REDACTED" nas
+// gravações, tanto da requisição quanto da resposta (ex: "Authorization", "Set-Cookie")
+func WithRedactedHeaders(names ...string) Option {
+	return func(r *Recorder) {
+		for _, name := range names {
+			r.redacted = append(r.redacted, http.CanonicalHeaderKey(name))
+		}
+	}
+}
+
+// WithHeaderNormalizer aplica fn aos cabeçalhos da requisição e da resposta antes de
+// gravá-los, por exemplo para remover "Date" e manter as gravações estáveis
+func WithHeaderNormalizer(fn func(http.Header)) Option {
+	return func(r *Recorder) {
+		r.normalizers = append(r.normalizers, fn)
+	}
+}
+
+// Interaction é o conteúdo de um arquivo de gravação
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest identifica a requisição gravada
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"headers,omitempty"`
+}
+
+// RecordedResponse é a resposta gravada. Corpos JSON são guardados em Body, formatados
+// junto com o restante do arquivo; os demais, como texto em BodyText.
+type RecordedResponse struct {
+	Status   int             `json:"status"`
+	Header   http.Header     `json:"headers,omitempty"`
+	Body     json.RawMessage `json:"body,omitempty"`
+	BodyText string          `json:"body_text,omitempty"`
+}
+
+// Recorder é um http.RoundTripper que grava ou reproduz interações HTTP. As requisições
+// são identificadas pelo método, caminho e query da URL, ignorando o host.
+// É seguro para uso concorrente.
+type Recorder struct {
+	dir         string
+	mode        Mode
+	transport   http.RoundTripper
+	redacted    []string
+	normalizers []func(http.Header)
+
+	mu           sync.Mutex
+	interactions map[string]*Interaction
+	unmatched    []string
+}
+
+// New cria um Recorder que grava ou lê as interações no diretório dir. No modo replay,
+// todas as gravações do diretório são carregadas imediatamente, e um diretório
+// inexistente resulta em um erro que envolve fs.ErrNotExist.
+func New(dir string, mode Mode, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		dir:          dir,
+		mode:         mode,
+		transport:    http.DefaultTransport,
+		interactions: make(map[string]*Interaction),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if mode == ModeRecord {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create fixture directory: %w", err)
+		}
+		return r, nil
+	}
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Client retorna um *http.Client que usa o Recorder como transporte
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Unmatched retorna as requisições sem gravação recebidas no modo replay
+func (r *Recorder) Unmatched() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.unmatched...)
+}
+
+// RoundTrip grava ou reproduz a requisição, de acordo com o modo
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ModeRecord {
+		return r.record(req)
+	}
+	return r.replay(req)
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	key := requestKey(req.Method, req.URL.RequestURI())
+
+	r.mu.Lock()
+	interaction, ok := r.interactions[key]
+	if !ok {
+		r.unmatched = append(r.unmatched, key)
+	}
+	r.mu.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoFixture, key)
+	}
+
+	body := []byte(interaction.Response.BodyText)
+	if len(interaction.Response.Body) > 0 {
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, interaction.Response.Body); err != nil {
+			return nil, fmt.Errorf("invalid fixture body for %s: %w", key, err)
+		}
+		body = compact.Bytes()
+	}
+
+	header := interaction.Response.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	// o corpo reproduzido é compactado e pode ter outro tamanho que o gravado
+	header.Del("Content-Length")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+		StatusCode:    interaction.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	interaction := &Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.RequestURI(),
+			Header: r.cleanHeader(req.Header),
+		},
+		Response: RecordedResponse{
+			Status: resp.StatusCode,
+			Header: r.cleanHeader(resp.Header),
+		},
+	}
+
+	if json.Valid(body) {
+		interaction.Response.Body = body
+	} else {
+		interaction.Response.BodyText = string(body)
+	}
+
+	if err := r.save(interaction); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// cleanHeader retorna uma cópia dos cabeçalhos com as redações e normalizações aplicadas
+func (r *Recorder) cleanHeader(header http.Header) http.Header {
+	cleaned := header.Clone()
+	if cleaned == nil {
+		cleaned = http.Header{}
+	}
+	for _, name := range r.redacted {
+		if _, ok := cleaned[name]; ok {
+			cleaned[name] = []string{redactedValue}
+		}
+	}
+	for _, normalize := range r.normalizers {
+		normalize(cleaned)
+	}
+	if len(cleaned) == 0 {
+		return nil
+	}
+	return cleaned
+}
+
+func (r *Recorder) save(interaction *Interaction) error {
+	data, err := json.MarshalIndent(interaction, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode fixture: %w", err)
+	}
+
+	key := requestKey(interaction.Request.Method, interaction.Request.URL)
+	path := filepath.Join(r.dir, fixtureName(key))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write fixture: %w", err)
+	}
+	r.interactions[key] = interaction
+	return nil
+}
+
+func (r *Recorder) load() error {
+	info, err := os.Stat(r.dir)
+	if err != nil {
+		return fmt.Errorf("failed to open fixture directory: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("fixture path %s is not a directory", r.dir)
+	}
+
+	paths, err := filepath.Glob(filepath.Join(r.dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read fixture: %w", err)
+		}
+
+		var interaction Interaction
+		if err := json.Unmarshal(data, &interaction); err != nil {
+			return fmt.Errorf("invalid fixture %s: %w", filepath.Base(path), err)
+		}
+		r.interactions[requestKey(interaction.Request.Method, interaction.Request.URL)] = &interaction
+	}
+	return nil
+}
+
+// requestKey identifica uma requisição pelo método e pelo caminho com query
+func requestKey(method, requestURI string) string {
+	return strings.ToUpper(method) + " " + requestURI
+}
+
+// fixtureName gera um nome de arquivo legível e único para a chave da requisição
+func fixtureName(key string) string {
+	name := strings.Trim(unsafeNameChars.ReplaceAllString(key, "_"), "_")
+	if len(name) > maxNameLength {
+		name = name[:maxNameLength]
+	}
+
+	sum := sha256.Sum256([]byte(key))
+	return fmt.Sprintf("%s_%s.json", name, hex.EncodeToString(sum[:4]))
+}
@@ -0,0 +1,121 @@
+package httpfixture
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Header().Set("Date", "Mon, 01 Jan 2024 00:00:00 GMT")
+		_, _ = w.Write([]byte(`{"data": ["al", "pe"], "total": 2, "path": "` + r.URL.Path + `"}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	recorder, err := New(dir, ModeRecord,
+		WithRedactedHeaders("set-cookie", "Authorization"),
+		WithHeaderNormalizer(func(h http.Header) { h.Del("Date") }),
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/api/v1/states?x=1", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err := recorder.Client().Do(req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(body), `"pe"`) {
+		t.Errorf("expected the live body to be returned while recording, got %s", body)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 1 || !strings.HasPrefix(filepath.Base(files[0]), "GET_api_v1_states_x_1_") {
+		t.Fatalf("expected one readable fixture file, got %v", files)
+	}
+	saved, _ := os.ReadFile(files[0])
+	for _, secret := range []string{"secret", "Bearer token", "Date"} {
+		if strings.Contains(string(saved), secret) {
+			t.Errorf("expected %q to be removed from the fixture:\n%s", secret, saved)
+		}
+	}
+	if !strings.Contains(string(saved), "\n      \"total\": 2") {
+		t.Errorf("expected the JSON body to be indented in the fixture:\n%s", saved)
+	}
+
+	replayer, err := New(dir, ModeReplay)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// o host é ignorado na comparação
+	resp, err = replayer.Client().Get("http://offline.invalid/api/v1/states?x=1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	replayed, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("unexpected replayed response: %d %v", resp.StatusCode, resp.Header)
+	}
+	if string(replayed) != `{"data":["al","pe"],"total":2,"path":"/api/v1/states"}` {
+		t.Errorf("unexpected replayed body: %s", replayed)
+	}
+	if calls != 1 {
+		t.Errorf("expected the server to be called only while recording, got %d calls", calls)
+	}
+}
+
+func TestRecorder_ReplayFailsOnUnmatchedRequests(t *testing.T) {
+	replayer, err := New(t.TempDir(), ModeReplay)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	_, err = replayer.Client().Get("http://offline.invalid/api/v1/harbors/1")
+	if !errors.Is(err, ErrNoFixture) {
+		t.Errorf("expected ErrNoFixture, got %v", err)
+	}
+	if unmatched := replayer.Unmatched(); len(unmatched) != 1 || unmatched[0] != "GET /api/v1/harbors/1" {
+		t.Errorf("expected the unmatched request to be reported, got %v", unmatched)
+	}
+}
+
+func TestRecorder_ReplayRequiresDirectory(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing"), ModeReplay)
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected fs.ErrNotExist, got %v", err)
+	}
+}
+
+func TestErrNoFixture_IsPermanent(t *testing.T) {
+	var permanent interface{ Permanent() bool }
+	if !errors.As(fmt.Errorf("wrapped: %w", ErrNoFixture), &permanent) || !permanent.Permanent() {
+		t.Error("expected ErrNoFixture to be reported as permanent")
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	if mode, err := ParseMode(" Record "); err != nil || mode != ModeRecord {
+		t.Errorf("expected ModeRecord, got %v (%v)", mode, err)
+	}
+	if _, err := ParseMode("live"); err == nil {
+		t.Error("expected an error for an unknown mode")
+	}
+}
@@ -5,14 +5,66 @@ package tabuamare
 
 import (
 	"context"
+	"errors"
+	"io/fs"
+	"net/http"
+	"os"
 	"testing"
 	"time"
+
+	"github.com/Ddiidev/sdks-tabua-mare/go/httpfixture"
 )
 
 // Para executar testes de integração: go test -v -tags=integration
+//
+// Com TABUAMARE_FIXTURES=record, as respostas reais são gravadas em testdata/fixtures;
+// com TABUAMARE_FIXTURES=replay, os testes usam apenas as gravações, sem acesso à rede,
+// e são ignorados se o diretório ainda não existir.
+
+// fixturesDir é o diretório das gravações usadas pelos testes de integração
+const fixturesDir = "testdata/fixtures"
+
+// newIntegrationClient cria o cliente dos testes de integração, gravando ou
+// reproduzindo as respostas conforme TABUAMARE_FIXTURES
+func newIntegrationClient(t *testing.T) *Client {
+	t.Helper()
+
+	value := os.Getenv("TABUAMARE_FIXTURES")
+	if value == "" {
+		return NewClient()
+	}
+
+	mode, err := httpfixture.ParseMode(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder, err := httpfixture.New(fixturesDir, mode,
+		httpfixture.WithRedactedHeaders("Authorization", "Cookie", "Set-Cookie"),
+		httpfixture.WithHeaderNormalizer(func(h http.Header) {
+			for _, name := range []string{"Date", "Cf-Ray", "Report-To", "Nel", "Server-Timing"} {
+				h.Del(name)
+			}
+		}),
+	)
+	if mode == httpfixture.ModeReplay && errors.Is(err, fs.ErrNotExist) {
+		t.Skipf("no fixtures in %s; record them with TABUAMARE_FIXTURES=record", fixturesDir)
+	}
+	if err != nil {
+		t.Fatalf("failed to open fixtures: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if unmatched := recorder.Unmatched(); len(unmatched) > 0 {
+			t.Errorf("requests without fixtures (record them with TABUAMARE_FIXTURES=record): %v", unmatched)
+		}
+	})
+
+	return NewClient(WithHTTPClient(recorder.Client()))
+}
 
 func TestIntegration_GetStates(t *testing.T) {
-	client := NewClient()
+	client := newIntegrationClient(t)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -29,7 +81,7 @@ func TestIntegration_GetStates(t *testing.T) {
 }
 
 func TestIntegration_GetHarborNames(t *testing.T) {
-	client := NewClient()
+	client := newIntegrationClient(t)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -49,7 +101,7 @@ func TestIntegration_GetHarborNames(t *testing.T) {
 }
 
 func TestIntegration_GetHarbor(t *testing.T) {
-	client := NewClient()
+	client := newIntegrationClient(t)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -68,7 +120,7 @@ func TestIntegration_GetHarbor(t *testing.T) {
 }
 
 func TestIntegration_GetTideTable(t *testing.T) {
-	client := NewClient()
+	client := newIntegrationClient(t)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -0,0 +1,77 @@
+package tabuamare
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// maxLoggedBody limita o tamanho dos corpos registrados em nível debug
+const maxLoggedBody = 2048
+
+// WithLogger registra cada requisição em logger. Requisições bem-sucedidas são
+// registradas em nível debug, junto com a URL e o corpo da resposta; novas tentativas
+// em nível warn; falhas em warn quando são transitórias ou canceladas, e em error
+// nos demais casos.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// logRequest registra o resultado de uma requisição
+func (c *Client) logRequest(ctx context.Context, info *RequestInfo, url string, status int, body []byte, duration time.Duration, err error) {
+	level := slog.LevelDebug
+	message := "tabuamare request"
+	if err != nil {
+		level = slog.LevelError
+		message = "tabuamare request failed"
+		if IsTemporary(err) || errors.Is(err, context.Canceled) {
+			level = slog.LevelWarn
+		}
+	}
+
+	if !c.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", info.Method),
+		slog.String("path", info.Path),
+		slog.Int("status", status),
+		slog.Duration("duration", duration),
+		slog.Int("bytes", len(body)),
+		slog.Bool("cache_hit", info.CacheHit),
+		slog.Int("attempt", info.Attempt),
+		slog.Duration("rate_limit_wait", info.RateLimitWait),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+	}
+	if level == slog.LevelDebug {
+		attrs = append(attrs, slog.String("url", url), slog.String("body", truncateBody(body)))
+	}
+
+	c.logger.LogAttrs(ctx, level, message, attrs...)
+}
+
+// logRetry registra uma tentativa que falhou e será repetida
+func logRetry(ctx context.Context, logger *slog.Logger, attempt RetryAttempt) {
+	logger.LogAttrs(ctx, slog.LevelWarn, "tabuamare request retry",
+		slog.String("method", attempt.Method),
+		slog.String("path", attempt.Path),
+		slog.Int("status", attempt.StatusCode),
+		slog.Int("attempt", attempt.Attempt),
+		slog.Duration("delay", attempt.Delay),
+		slog.Any("error", attempt.Err),
+	)
+}
+
+// truncateBody limita o corpo a maxLoggedBody bytes
+func truncateBody(body []byte) string {
+	if len(body) <= maxLoggedBody {
+		return string(body)
+	}
+	return string(body[:maxLoggedBody]) + "..."
+}
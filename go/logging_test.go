@@ -0,0 +1,96 @@
+package tabuamare
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithLogger_Levels(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/harbors/9" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": 404, "msg": "harbor not found"}`))
+			return
+		}
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": ["al"], "total": 1}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client := NewClient(
+		WithBaseURL(server.URL),
+		WithLogger(logger),
+		WithCache(NewMemoryCache(0)),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}),
+	)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetStates(ctx); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if _, err := client.GetHarbor(ctx, 9); err == nil {
+		t.Fatal("expected error")
+	}
+
+	lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected 4 records, got:\n%s", logs.String())
+	}
+
+	expected := []string{
+		`level=WARN msg="tabuamare request retry" method=GET path=/states status=503 attempt=1`,
+		`level=DEBUG msg="tabuamare request" method=GET path=/states status=200`,
+		`level=DEBUG msg="tabuamare request" method=GET path=/states status=200`,
+		`level=ERROR msg="tabuamare request failed" method=GET path=/harbors/9 status=404`,
+	}
+	for i, want := range expected {
+		if !strings.Contains(lines[i], want) {
+			t.Errorf("record %d: expected %q, got %s", i, want, lines[i])
+		}
+	}
+
+	if !strings.Contains(lines[1], "cache_hit=false attempt=2") || !strings.Contains(lines[1], "url="+server.URL+"/states") {
+		t.Errorf("expected the attempt and URL in the debug record, got %s", lines[1])
+	}
+	if !strings.Contains(lines[2], "cache_hit=true") || !strings.Contains(lines[2], "bytes=28") {
+		t.Errorf("expected a cache hit, got %s", lines[2])
+	}
+	if strings.Contains(lines[3], "url=") || strings.Contains(lines[3], "body=") {
+		t.Errorf("expected no URL or body outside debug level, got %s", lines[3])
+	}
+}
+
+func TestWithLogger_QuietOnSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data": [], "total": 0}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+
+	client := NewClient(WithBaseURL(server.URL), WithLogger(logger))
+	if _, err := client.GetStates(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if logs.Len() != 0 {
+		t.Errorf("expected no records at info level, got:\n%s", logs.String())
+	}
+}
@@ -0,0 +1,207 @@
+package tabuamare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Doer executa uma requisição HTTP. *http.Client implementa Doer.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc adapta uma função ao Doer
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do chama f(req)
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware envolve um Doer, podendo alterar a requisição antes de repassá-la a next
+// e a resposta antes de devolvê-la. Respostas com status de erro (4xx e 5xx) chegam
+// aos middlewares como respostas; apenas falhas de rede e cancelamentos são erros.
+type Middleware func(next Doer) Doer
+
+// WithMiddleware adiciona middlewares à cadeia de requisições. O primeiro middleware
+// informado é o mais externo. Os middlewares de WithCache e WithRetryPolicy ficam depois
+// dos adicionados aqui, seguidos do compartilhamento de requisições GET idênticas e
+// concorrentes e do limite de WithRateLimiter. Para controlar a posição do cache e das
+// novas tentativas, use CacheMiddleware e RetryMiddleware diretamente em WithMiddleware,
+// no lugar dessas opções. Um RateLimitMiddleware adicionado aqui aguarda antes do
+// compartilhamento; prefira WithRateLimiter para que chamadas idênticas usem uma só cota.
+func WithMiddleware(mw ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.middlewares = append(c.middlewares, mw...)
+	}
+}
+
+// RequestInfo acompanha uma requisição do cliente pela cadeia de middlewares
+type RequestInfo struct {
+	Method string
+	// Path é o caminho relativo à URL base (ex: "/harbors/1,2"), usado como chave de cache
+	Path string
+	// Attempt é o número da tentativa atual, começando em 1
+	Attempt int
+	// CacheHit informa se a resposta veio do cache
+	CacheHit bool
+	// RateLimitWait é o tempo total de espera no limite de requisições
+	RateLimitWait time.Duration
+
+	cacheTTL time.Duration
+	logger   *slog.Logger
+}
+
+type requestInfoKey struct{}
+
+// RequestInfoFromContext retorna as informações da requisição em andamento, disponíveis
+// no contexto das requisições que passam pelos middlewares
+func RequestInfoFromContext(ctx context.Context) (*RequestInfo, bool) {
+	info, ok := ctx.Value(requestInfoKey{}).(*RequestInfo)
+	return info, ok
+}
+
+// requestPath retorna o caminho da requisição relativo à URL base, quando conhecido
+func requestPath(req *http.Request) string {
+	if info, ok := RequestInfoFromContext(req.Context()); ok {
+		return info.Path
+	}
+	return req.URL.RequestURI()
+}
+
+// buildChain monta a cadeia de middlewares sobre o transporte do cliente
+func (c *Client) buildChain() Doer {
+	chain := append([]Middleware(nil), c.middlewares...)
+	if c.cache != nil {
+		chain = append(chain, CacheMiddleware(c.cache))
+	}
+	if c.retryPolicy != nil {
+		chain = append(chain, RetryMiddleware(*c.retryPolicy))
+	}
+	chain = append(chain, coalesce(&c.inflight))
+	if c.rateLimiter != nil {
+		chain = append(chain, RateLimitMiddleware(c.rateLimiter))
+	}
+
+	var doer Doer = DoerFunc(c.transport)
+	for i := len(chain) - 1; i >= 0; i-- {
+		doer = chain[i](doer)
+	}
+	return doer
+}
+
+// coalesce faz com que requisições GET idênticas e concorrentes compartilhem a mesma
+// requisição. Fica antes do limite de requisições, para que os chamadores que se juntam
+// a uma requisição em andamento não consumam nem aguardem outra cota.
+func coalesce(group *flightGroup) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet {
+				return next.Do(req)
+			}
+
+			body, resp, err := group.do(req.Context(), req.Method+" "+req.URL.String(), func(ctx context.Context) ([]byte, *http.Response, error) {
+				resp, err := next.Do(req.WithContext(ctx))
+				if err != nil {
+					return nil, nil, err
+				}
+				body, err := readBody(resp)
+				if err != nil {
+					return nil, nil, err
+				}
+				return body, resp, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			return withBody(resp, req, body), nil
+		})
+	}
+}
+
+// transport executa a requisição HTTP e lê o corpo por inteiro
+func (c *Client) transport(req *http.Request) (*http.Response, error) {
+	body, resp, err := c.roundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	return withBody(resp, req, body), nil
+}
+
+// roundTrip executa a requisição HTTP e retorna o corpo lido
+func (c *Client) roundTrip(req *http.Request) ([]byte, *http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if isPermanent(err) {
+			return nil, nil, err
+		}
+		return nil, nil, &NetworkError{Err: err}
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	return body, resp, nil
+}
+
+// isPermanent informa se o transporte sinalizou, com um método Permanent() bool, que a
+// falha não é de rede e não adianta repetir a requisição (ex: httpfixture.ErrNoFixture)
+func isPermanent(err error) bool {
+	var permanent interface{ Permanent() bool }
+	return errors.As(err, &permanent) && permanent.Permanent()
+}
+
+// withBody retorna uma cópia de resp para req com um leitor próprio de body, para
+// que chamadores que compartilham a resposta não disputem o mesmo corpo
+func withBody(resp *http.Response, req *http.Request, body []byte) *http.Response {
+	shared := *resp
+	shared.Header = resp.Header.Clone()
+	shared.Body = io.NopCloser(bytes.NewReader(body))
+	shared.ContentLength = int64(len(body))
+	shared.Request = req
+	return &shared
+}
+
+// readBody lê o corpo da resposta e o substitui por uma cópia, para que possa ser
+// lido de novo pelos middlewares seguintes
+func readBody(resp *http.Response) ([]byte, error) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// responseError converte respostas com status de erro em erros do SDK
+func responseError(resp *http.Response, body []byte) error {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return ErrRateLimitExceeded
+	}
+
+	if resp.StatusCode >= 400 {
+		var apiErr APIError
+		if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Message != "" {
+			apiErr.Status = resp.StatusCode
+			return &apiErr
+		}
+		return &APIError{
+			Status:  resp.StatusCode,
+			Code:    resp.StatusCode,
+			Message: string(body),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package tabuamare
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithMiddleware_InjectsHeadersAndMutatesResponses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") != "tabuamare-test" || r.Header.Get("X-Correlation-ID") != "abc" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": ["al"], "total": 1}`))
+	}))
+	defer server.Close()
+
+	headers := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("User-Agent", "tabuamare-test")
+			req.Header.Set("X-Correlation-ID", "abc")
+			return next.Do(req)
+		})
+	}
+	rewrite := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := next.Do(req)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body.Close()
+			resp.Body = io.NopCloser(strings.NewReader(`{"data": ["pe"], "total": 1}`))
+			return resp, nil
+		})
+	}
+
+	client := NewClient(WithBaseURL(server.URL), WithMiddleware(headers, rewrite))
+	states, err := client.GetStates(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(states) != 1 || states[0] != "pe" {
+		t.Errorf("expected the rewritten response, got %v", states)
+	}
+}
+
+func TestWithMiddleware_Ordering(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": ["al"], "total": 1}`))
+	}))
+	defer server.Close()
+
+	var outer, inner []string
+	trace := func(calls *[]string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				info, _ := RequestInfoFromContext(req.Context())
+				resp, err := next.Do(req)
+				*calls = append(*calls, info.Path)
+				if info.CacheHit {
+					*calls = append(*calls, "hit")
+				}
+				return resp, err
+			})
+		}
+	}
+
+	policy := RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+	client := NewClient(
+		WithBaseURL(server.URL),
+		WithMiddleware(
+			trace(&outer),
+			CacheMiddleware(NewMemoryCache(0)),
+			RetryMiddleware(policy),
+			trace(&inner),
+		),
+	)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetStates(ctx); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("expected 2 requests to the server, got %d", got)
+	}
+	if len(outer) != 3 || outer[0] != "/states" || outer[2] != "hit" {
+		t.Errorf("expected the outer middleware to see both calls and the cache hit, got %v", outer)
+	}
+	if len(inner) != 2 {
+		t.Errorf("expected the inner middleware to see each attempt, got %v", inner)
+	}
+}
+
+func TestRateLimitMiddleware_RecordsWait(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data": [], "total": 0}`))
+	}))
+	defer server.Close()
+
+	var waited time.Duration
+	observe := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := next.Do(req)
+			info, _ := RequestInfoFromContext(req.Context())
+			waited = info.RateLimitWait
+			return resp, err
+		})
+	}
+
+	client := NewClient(
+		WithBaseURL(server.URL),
+		WithMiddleware(observe, RateLimitMiddleware(NewRateLimiter(1, 50*time.Millisecond))),
+	)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetHarborNames(ctx, "pe"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	if waited < 30*time.Millisecond {
+		t.Errorf("expected the second request to wait for the rate limiter, got %s", waited)
+	}
+}
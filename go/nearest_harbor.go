@@ -5,26 +5,155 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"sort"
+	"strings"
 )
 
-// GetNearestHarbor retorna o porto mais próximo de uma coordenada geográfica
+// NearestHarborsOptions define os filtros de GetNearestHarbors
+type NearestHarborsOptions struct {
+	Limit       int     // quantidade máxima de portos retornados; 0 retorna todos
+	MaxDistance float64 // distância máxima em quilômetros; 0 não limita
+	State       string  // sigla do estado (ex: "pe"); vazio não filtra
+}
+
+// WithHarborIndex configura um índice local de portos. GetNearestHarbors usa o índice
+// como fonte de dados junto com a API, e GetNearestHarbor e GetNearestHarbors respondem
+// com ele quando a consulta à API falha por um erro transitório (veja IsTemporary).
+// Os portos vindos do índice têm NearestHarbor.FromIndex == true.
+func WithHarborIndex(index *HarborIndex) ClientOption {
+	return func(c *Client) {
+		c.harborIndex = index
+	}
+}
+
+// WithHarborIndexFallback configura um índice local de portos.
+//
+// Deprecated: use WithHarborIndex, que tem o mesmo comportamento.
+func WithHarborIndexFallback(index *HarborIndex) ClientOption {
+	return WithHarborIndex(index)
+}
+
+// GetNearestHarbor retorna o porto mais próximo de uma coordenada geográfica. Se a
+// consulta à API falhar por um erro transitório e houver um índice configurado com
+// WithHarborIndex, retorna o porto mais próximo do índice, com FromIndex == true.
 func (c *Client) GetNearestHarbor(ctx context.Context, lat, lng float64) (*NearestHarbor, error) {
-	if math.IsNaN(lat) || math.IsInf(lat, 0) {
-		return nil, &ValidationError{Field: "lat", Message: "latitude must be a valid number"}
+	if err := validateLatLng(lat, lng); err != nil {
+		return nil, err
 	}
 
-	if math.IsNaN(lng) || math.IsInf(lng, 0) {
-		return nil, &ValidationError{Field: "lng", Message: "longitude must be a valid number"}
+	harbors, err := c.fetchNearestHarbors(ctx, lat, lng)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		if c.canFallBack(err) {
+			if local := c.harborIndex.Nearest(lat, lng, 1); len(local) > 0 {
+				local[0].FromIndex = true
+				return &local[0], nil
+			}
+		}
+		return nil, err
 	}
 
-	if lat < -90 || lat > 90 {
-		return nil, &ValidationError{Field: "lat", Message: "latitude must be between -90 and 90 degrees"}
+	withBearing(lat, lng, &harbors[0])
+	return &harbors[0], nil
+}
+
+// GetNearestHarbors retorna os portos mais próximos de uma coordenada geográfica, do mais
+// próximo ao mais distante, com a distância, o rumo inicial e a direção a partir do ponto
+// consultado.
+//
+// O endpoint da API informa apenas o porto mais próximo; sem um índice configurado com
+// WithHarborIndex, o resultado tem no máximo esse porto, e Limit e State têm pouco efeito.
+// Com o índice, seus portos complementam a resposta da API e a substituem quando a
+// consulta falha por um erro transitório. Os portos vindos do índice têm
+// FromIndex == true, para que o chamador saiba quais não vieram da API.
+func (c *Client) GetNearestHarbors(ctx context.Context, lat, lng float64, opts NearestHarborsOptions) ([]NearestHarbor, error) {
+	if err := validateLatLng(lat, lng); err != nil {
+		return nil, err
 	}
 
-	if lng < -180 || lng > 180 {
-		return nil, &ValidationError{Field: "lng", Message: "longitude must be between -180 and 180 degrees"}
+	if opts.Limit < 0 {
+		return nil, &ValidationError{Field: "limit", Message: "limit must not be negative"}
+	}
+
+	if opts.MaxDistance < 0 || math.IsNaN(opts.MaxDistance) {
+		return nil, &ValidationError{Field: "max_distance", Message: "max distance must not be negative"}
+	}
+
+	harbors, err := c.fetchNearestHarbors(ctx, lat, lng)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		if !c.canFallBack(err) {
+			return nil, err
+		}
+	}
+
+	for i := range harbors {
+		withBearing(lat, lng, &harbors[i])
+	}
+
+	if c.harborIndex != nil {
+		seen := make(map[int]bool, len(harbors))
+		for _, harbor := range harbors {
+			seen[harbor.ID] = true
+		}
+		for _, local := range c.harborIndex.byDistance(lat, lng) {
+			if !seen[local.ID] {
+				local.FromIndex = true
+				harbors = append(harbors, local)
+			}
+		}
+	}
+
+	if err != nil && len(harbors) == 0 {
+		return nil, err
+	}
+
+	results := make([]NearestHarbor, 0, len(harbors))
+	for _, harbor := range harbors {
+		if opts.State != "" && !strings.EqualFold(harbor.State, strings.TrimSpace(opts.State)) {
+			continue
+		}
+		if opts.MaxDistance > 0 && harbor.Distance > opts.MaxDistance {
+			continue
+		}
+		results = append(results, harbor)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Distance < results[j].Distance
+	})
+
+	if opts.Limit > 0 && len(results) > opts.Limit {
+		results = results[:opts.Limit]
+	}
+
+	return results, nil
+}
+
+// canFallBack informa se a falha da consulta à API permite responder com o índice local.
+// Erros de validação e respostas 4xx indicam uma consulta inválida e não são substituídos.
+func (c *Client) canFallBack(err error) bool {
+	return c.harborIndex != nil && IsTemporary(err)
+}
+
+// withBearing preenche o rumo e a direção do ponto consultado até o porto, quando o
+// porto tem coordenadas válidas
+func withBearing(lat, lng float64, harbor *NearestHarbor) {
+	harborLat, harborLng, err := harbor.Coordinates()
+	if err != nil && !isCoordinateMismatch(err) {
+		return
 	}
 
+	harbor.Bearing = InitialBearing(lat, lng, harborLat, harborLng)
+	harbor.Direction = CompassDirection(harbor.Bearing)
+}
+
+// fetchNearestHarbors consulta a API pelos portos mais próximos de uma coordenada
+func (c *Client) fetchNearestHarbors(ctx context.Context, lat, lng float64) ([]NearestHarbor, error) {
 	latLng := fmt.Sprintf("%.6f,%.6f", lat, lng)
 	path := fmt.Sprintf("/nearest-harbor-independent-state/%s", latLng)
 
@@ -46,5 +175,26 @@ func (c *Client) GetNearestHarbor(ctx context.Context, lat, lng float64) (*Neare
 		return nil, ErrEmptyResponse
 	}
 
-	return &response.Data[0], nil
+	return response.Data, nil
+}
+
+// validateLatLng verifica se a latitude e a longitude são coordenadas válidas
+func validateLatLng(lat, lng float64) error {
+	if math.IsNaN(lat) || math.IsInf(lat, 0) {
+		return &ValidationError{Field: "lat", Message: "latitude must be a valid number", Err: ErrInvalidCoordinates}
+	}
+
+	if math.IsNaN(lng) || math.IsInf(lng, 0) {
+		return &ValidationError{Field: "lng", Message: "longitude must be a valid number", Err: ErrInvalidCoordinates}
+	}
+
+	if lat < -90 || lat > 90 {
+		return &ValidationError{Field: "lat", Message: "latitude must be between -90 and 90 degrees", Err: ErrInvalidCoordinates}
+	}
+
+	if lng < -180 || lng > 180 {
+		return &ValidationError{Field: "lng", Message: "longitude must be between -180 and 180 degrees", Err: ErrInvalidCoordinates}
+	}
+
+	return nil
 }
@@ -151,3 +151,105 @@ func TestGetNearestHarbor_EmptyResponse(t *testing.T) {
 		t.Errorf("expected ErrEmptyResponse, got %v", err)
 	}
 }
+
+func TestGetNearestHarbors_FiltersAndSorts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		response := NearestHarborResponse{
+			Data: []NearestHarbor{
+				{Harbor: testHarbor(2, "PORTO DO RECIFE", "pe", -8.05, -34.866667), Distance: 120},
+				{Harbor: testHarbor(1, "PORTO DE MACEIÓ", "al", -9.683333, -35.716667), Distance: 90},
+			},
+			Total: 2,
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithBaseURL(server.URL),
+		WithHarborIndex(NewHarborIndex(testHarbors())),
+	)
+
+	// ponto entre Maceió e Recife: Recife fica ao norte e Maceió ao sul
+	harbors, err := client.GetNearestHarbors(context.Background(), -8.9, -35.2, NearestHarborsOptions{MaxDistance: 500})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(harbors) != 2 || harbors[0].ID != 1 || harbors[1].ID != 2 {
+		t.Fatalf("expected Maceió then Recife, got %+v", harbors)
+	}
+	if harbors[0].Direction != "SSW" || harbors[1].Direction != "NNE" {
+		t.Errorf("expected SSW and NNE, got %s and %s", harbors[0].Direction, harbors[1].Direction)
+	}
+	if harbors[0].FromIndex || harbors[1].FromIndex {
+		t.Error("expected the API harbors not to be marked as coming from the index")
+	}
+
+	harbors, err = client.GetNearestHarbors(context.Background(), -8.9, -35.2, NearestHarborsOptions{State: "SP"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(harbors) != 1 || harbors[0].ID != 3 || !harbors[0].FromIndex {
+		t.Errorf("expected only Santos from the local index, got %+v", harbors)
+	}
+
+	harbors, err = client.GetNearestHarbors(context.Background(), -8.9, -35.2, NearestHarborsOptions{Limit: 3})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(harbors) != 3 || harbors[2].ID != 3 {
+		t.Errorf("expected the 3 closest harbors, got %+v", harbors)
+	}
+}
+
+func TestGetNearestHarbors_FallsBackToIndex(t *testing.T) {
+	status := http.StatusServiceUnavailable
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithBaseURL(server.URL),
+		WithHarborIndex(NewHarborIndex(testHarbors())),
+	)
+
+	harbors, err := client.GetNearestHarbors(context.Background(), -8.9, -35.2, NearestHarborsOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("expected the index to answer a temporary failure, got %v", err)
+	}
+	if len(harbors) != 2 || harbors[0].ID != 2 || harbors[1].ID != 1 {
+		t.Errorf("expected Recife and Maceió from the index, got %+v", harbors)
+	}
+	if !harbors[0].FromIndex || !harbors[1].FromIndex {
+		t.Error("expected the harbors to be marked as coming from the index")
+	}
+
+	status = http.StatusBadRequest
+	if harbors, err := client.GetNearestHarbors(context.Background(), -8.9, -35.2, NearestHarborsOptions{}); !errors.Is(err, ErrBadRequest) || harbors != nil {
+		t.Errorf("expected ErrBadRequest without results, got %+v (%v)", harbors, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if harbors, err := client.GetNearestHarbors(ctx, -8.9, -35.2, NearestHarborsOptions{}); !errors.Is(err, context.Canceled) || harbors != nil {
+		t.Errorf("expected context.Canceled without results, got %+v (%v)", harbors, err)
+	}
+}
+
+func TestGetNearestHarbors_InvalidOptions(t *testing.T) {
+	client := NewClient()
+
+	testCases := []NearestHarborsOptions{
+		{Limit: -1},
+		{MaxDistance: -5},
+		{MaxDistance: math.NaN()},
+	}
+
+	for _, opts := range testCases {
+		var valErr *ValidationError
+		if _, err := client.GetNearestHarbors(context.Background(), 0, 0, opts); !errors.As(err, &valErr) {
+			t.Errorf("%+v: expected ValidationError, got %v", opts, err)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package tabuamare
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// InterpolationMethod define como o nível é estimado entre duas marés consecutivas
+type InterpolationMethod int
+
+const (
+	// InterpolationCosine usa a curva de meio cosseno entre preamar e baixa-mar
+	InterpolationCosine InterpolationMethod = iota
+	// InterpolationTwelfths usa a regra dos doze avos (1, 2, 3, 3, 2, 1)
+	InterpolationTwelfths
+)
+
+// twelfths é a fração acumulada da variação ao fim de cada sexto do intervalo
+var twelfths = [...]float64{0, 1.0 / 12, 3.0 / 12, 6.0 / 12, 9.0 / 12, 11.0 / 12, 1}
+
+// TideTrend indica se a maré está subindo ou descendo
+type TideTrend int
+
+const (
+	// TideSlack indica maré parada, sem variação entre os extremos vizinhos
+	TideSlack TideTrend = iota
+	// TideRising indica maré enchente
+	TideRising
+	// TideFalling indica maré vazante
+	TideFalling
+)
+
+// String retorna o nome da tendência da maré
+func (t TideTrend) String() string {
+	switch t {
+	case TideRising:
+		return "rising"
+	case TideFalling:
+		return "falling"
+	}
+	return "slack"
+}
+
+// MarshalText serializa a tendência da maré pelo seu nome
+func (t TideTrend) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// TideReading é o nível estimado da maré em um instante
+type TideReading struct {
+	Time  time.Time `json:"time"`
+	Level float64   `json:"level"`
+	Trend TideTrend `json:"trend"`
+}
+
+// TidePredictor estima o nível da maré em qualquer instante entre a primeira e a
+// última maré conhecida, interpolando entre extremos consecutivos
+type TidePredictor struct {
+	events []TideEvent
+	method InterpolationMethod
+}
+
+// NewTidePredictor cria um preditor a partir de marés extremas (preamares e baixa-mares)
+func NewTidePredictor(events []TideEvent, method InterpolationMethod) (*TidePredictor, error) {
+	if method != InterpolationCosine && method != InterpolationTwelfths {
+		return nil, &ValidationError{Field: "method", Message: "unknown interpolation method"}
+	}
+
+	sorted := append([]TideEvent(nil), events...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+
+	// Eventos no mesmo instante não formam um intervalo; mantém apenas o primeiro
+	unique := sorted[:0]
+	for _, event := range sorted {
+		if n := len(unique); n > 0 && event.Time.Equal(unique[n-1].Time) {
+			continue
+		}
+		unique = append(unique, event)
+	}
+	sorted = unique
+
+	if len(sorted) < 2 {
+		return nil, &ValidationError{Field: "events", Message: "at least two tide events are required"}
+	}
+
+	return &TidePredictor{events: sorted, method: method}, nil
+}
+
+// Predictor cria um TidePredictor com as marés da tábua
+func (t TideTable) Predictor(method InterpolationMethod) (*TidePredictor, error) {
+	events, err := t.Events()
+	if err != nil {
+		return nil, err
+	}
+	return NewTidePredictor(events, method)
+}
+
+// Start retorna o primeiro instante coberto pelo preditor
+func (p *TidePredictor) Start() time.Time {
+	return p.events[0].Time
+}
+
+// End retorna o último instante coberto pelo preditor
+func (p *TidePredictor) End() time.Time {
+	return p.events[len(p.events)-1].Time
+}
+
+// LevelAt retorna o nível estimado da maré, em metros, no instante t
+func (p *TidePredictor) LevelAt(t time.Time) (float64, error) {
+	reading, err := p.At(t)
+	if err != nil {
+		return 0, err
+	}
+	return reading.Level, nil
+}
+
+// At retorna o nível estimado e a tendência da maré no instante t
+func (p *TidePredictor) At(t time.Time) (TideReading, error) {
+	if t.Before(p.Start()) || t.After(p.End()) {
+		return TideReading{}, fmt.Errorf("%w: %s is not between %s and %s",
+			ErrTimeOutOfRange, t.Format(time.RFC3339), p.Start().Format(time.RFC3339), p.End().Format(time.RFC3339))
+	}
+
+	// Primeiro evento posterior a t; o segmento vai de next-1 até next
+	next := sort.Search(len(p.events), func(i int) bool {
+		return p.events[i].Time.After(t)
+	})
+	if next == len(p.events) {
+		next--
+	}
+	from, to := p.events[next-1], p.events[next]
+
+	fraction := float64(t.Sub(from.Time)) / float64(to.Time.Sub(from.Time))
+	level := from.Level + (to.Level-from.Level)*p.progress(fraction)
+
+	trend := TideSlack
+	switch {
+	case to.Level > from.Level:
+		trend = TideRising
+	case to.Level < from.Level:
+		trend = TideFalling
+	}
+
+	return TideReading{Time: t, Level: level, Trend: trend}, nil
+}
+
+// progress converte a fração de tempo decorrido na fração da variação de nível
+func (p *TidePredictor) progress(fraction float64) float64 {
+	if p.method == InterpolationTwelfths {
+		scaled := fraction * 6
+		sixth := min(int(scaled), 5)
+		return twelfths[sixth] + (twelfths[sixth+1]-twelfths[sixth])*(scaled-float64(sixth))
+	}
+	return (1 - math.Cos(math.Pi*fraction)) / 2
+}
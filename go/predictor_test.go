@@ -0,0 +1,82 @@
+package tabuamare
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTidePredictor_Cosine(t *testing.T) {
+	events := eventsFromLevels(0.2, 2.2, 0.2)
+	predictor, err := NewTidePredictor(events, InterpolationCosine)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	testCases := []struct {
+		offset time.Duration
+		level  float64
+		trend  TideTrend
+	}{
+		{0, 0.2, TideRising},
+		{3 * time.Hour, 1.2, TideRising},
+		{2 * time.Hour, 0.2 + 2*0.25, TideRising},
+		{6 * time.Hour, 2.2, TideFalling},
+		{9 * time.Hour, 1.2, TideFalling},
+		{12 * time.Hour, 0.2, TideFalling},
+	}
+
+	for _, tc := range testCases {
+		reading, err := predictor.At(events[0].Time.Add(tc.offset))
+		if err != nil {
+			t.Fatalf("offset %s: expected no error, got %v", tc.offset, err)
+		}
+		if math.Abs(reading.Level-tc.level) > 1e-9 {
+			t.Errorf("offset %s: expected level %f, got %f", tc.offset, tc.level, reading.Level)
+		}
+		if reading.Trend != tc.trend {
+			t.Errorf("offset %s: expected %s, got %s", tc.offset, tc.trend, reading.Trend)
+		}
+	}
+}
+
+func TestTidePredictor_RuleOfTwelfths(t *testing.T) {
+	events := eventsFromLevels(0, 1.2)
+	predictor, err := NewTidePredictor(events, InterpolationTwelfths)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := []float64{0, 0.1, 0.3, 0.6, 0.9, 1.1, 1.2}
+	for hour, level := range want {
+		got, err := predictor.LevelAt(events[0].Time.Add(time.Duration(hour) * time.Hour))
+		if err != nil {
+			t.Fatalf("hour %d: expected no error, got %v", hour, err)
+		}
+		if math.Abs(got-level) > 1e-9 {
+			t.Errorf("hour %d: expected %f, got %f", hour, level, got)
+		}
+	}
+}
+
+func TestTidePredictor_OutOfRange(t *testing.T) {
+	events := eventsFromLevels(0.2, 2.2)
+	predictor, err := NewTidePredictor(events, InterpolationCosine)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for _, instant := range []time.Time{events[0].Time.Add(-time.Second), events[1].Time.Add(time.Second)} {
+		if _, err := predictor.LevelAt(instant); !errors.Is(err, ErrTimeOutOfRange) {
+			t.Errorf("%s: expected ErrTimeOutOfRange, got %v", instant, err)
+		}
+	}
+}
+
+func TestNewTidePredictor_RequiresTwoEvents(t *testing.T) {
+	var valErr *ValidationError
+	if _, err := NewTidePredictor(eventsFromLevels(1), InterpolationCosine); !errors.As(err, &valErr) {
+		t.Errorf("expected ValidationError, got %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+package tabuamare
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRateLimit é o número de requisições permitidas pela API por DefaultRatePeriod
+	DefaultRateLimit = 500
+	// DefaultRatePeriod é a janela em que DefaultRateLimit requisições são permitidas
+	DefaultRatePeriod = time.Minute
+)
+
+// RateLimiter é um token bucket seguro para uso concorrente.
+// Uma mesma instância pode ser compartilhada entre vários Clients
+// para que todos consumam a mesma cota.
+type RateLimiter struct {
+	mu       sync.Mutex
+	capacity float64
+	tokens   float64
+	interval time.Duration // tempo para repor um token
+	last     time.Time
+}
+
+// NewRateLimiter cria um limitador que permite n requisições a cada per, com rajadas de até n
+func NewRateLimiter(n int, per time.Duration) *RateLimiter {
+	if n < 1 {
+		n = 1
+	}
+	if per <= 0 {
+		per = DefaultRatePeriod
+	}
+
+	interval := per / time.Duration(n)
+	if interval <= 0 {
+		interval = time.Nanosecond
+	}
+
+	return &RateLimiter{
+		capacity: float64(n),
+		tokens:   float64(n),
+		interval: interval,
+		last:     time.Now(),
+	}
+}
+
+// WithRateLimit limita o cliente a n requisições a cada per
+func WithRateLimit(n int, per time.Duration) ClientOption {
+	return WithRateLimiter(NewRateLimiter(n, per))
+}
+
+// WithRateLimiter configura um RateLimiter compartilhado com outros clientes
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.rateLimiter = limiter
+	}
+}
+
+// RateLimitMiddleware aguarda limiter antes de repassar cada requisição
+func RateLimitMiddleware(limiter *RateLimiter) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			if err := limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+			if info, ok := RequestInfoFromContext(req.Context()); ok {
+				info.RateLimitWait += time.Since(start)
+			}
+			return next.Do(req)
+		})
+	}
+}
+
+// Wait bloqueia até que uma requisição possa ser feita ou o contexto seja cancelado
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+		l.cancel()
+		return context.DeadlineExceeded
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve consome um token e retorna quanto tempo falta até ele estar disponível
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens * float64(l.interval))
+}
+
+// cancel devolve um token reservado por uma espera que não foi concluída
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(time.Now())
+	l.tokens = min(l.tokens+1, l.capacity)
+}
+
+// refill repõe os tokens acumulados desde a última atualização
+func (l *RateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last)
+	if elapsed <= 0 {
+		return
+	}
+
+	l.last = now
+	l.tokens = min(l.tokens+float64(elapsed)/float64(l.interval), l.capacity)
+}
@@ -0,0 +1,90 @@
+package tabuamare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_AllowsBurstThenWaits(t *testing.T) {
+	limiter := NewRateLimiter(2, 100*time.Millisecond)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("expected the third call to wait for a token, took %s", elapsed)
+	}
+}
+
+func TestRateLimiter_RespectsCancellation(t *testing.T) {
+	limiter := NewRateLimiter(1, time.Hour)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	if err := limiter.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestRateLimiter_FailsFastWhenDeadlineIsTooShort(t *testing.T) {
+	limiter := NewRateLimiter(1, time.Hour)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := limiter.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestWithRateLimiter_SharedAcrossClients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data": [], "total": 0}`))
+	}))
+	defer server.Close()
+
+	limiter := NewRateLimiter(4, 200*time.Millisecond)
+	clients := []*Client{
+		NewClient(WithBaseURL(server.URL), WithRateLimiter(limiter)),
+		NewClient(WithBaseURL(server.URL), WithRateLimiter(limiter)),
+	}
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func(client *Client, path string) {
+			defer wg.Done()
+			if _, err := client.doRequest(context.Background(), "GET", path); err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		}(clients[i%2], fmt.Sprintf("/test/%d", i))
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("expected requests beyond the shared burst to wait, took %s", elapsed)
+	}
+}
@@ -0,0 +1,202 @@
+package tabuamare
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 4
+	defaultRetryBaseDelay   = 500 * time.Millisecond
+	defaultRetryMaxDelay    = 30 * time.Second
+)
+
+// RetryPolicy configura as novas tentativas automáticas de requisições idempotentes
+type RetryPolicy struct {
+	// MaxAttempts é o número máximo de tentativas, incluindo a primeira
+	MaxAttempts int
+	// BaseDelay é o atraso antes da segunda tentativa; dobra a cada nova tentativa
+	BaseDelay time.Duration
+	// MaxDelay limita o atraso entre tentativas, inclusive o indicado por Retry-After
+	MaxDelay time.Duration
+	// OnRetry, se definido, é chamado antes de cada nova tentativa
+	OnRetry func(RetryAttempt)
+}
+
+// RetryAttempt descreve uma tentativa que falhou e será repetida
+type RetryAttempt struct {
+	Method     string
+	Path       string
+	Attempt    int           // número da tentativa que falhou, começando em 1
+	StatusCode int           // status HTTP da resposta, ou 0 em caso de erro de rede
+	Err        error         // erro que motivou a nova tentativa
+	Delay      time.Duration // tempo de espera até a próxima tentativa
+}
+
+// DefaultRetryPolicy retorna a política de novas tentativas recomendada
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: defaultRetryMaxAttempts,
+		BaseDelay:   defaultRetryBaseDelay,
+		MaxDelay:    defaultRetryMaxDelay,
+	}
+}
+
+// WithRetryPolicy habilita novas tentativas com backoff exponencial para requisições GET
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		policy = policy.normalized()
+		c.retryPolicy = &policy
+	}
+}
+
+// RetryMiddleware repete requisições idempotentes em falhas transitórias, seguindo a
+// política informada. Cada tentativa percorre novamente os middlewares seguintes.
+func RetryMiddleware(policy RetryPolicy) Middleware {
+	policy = policy.normalized()
+
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			if !isIdempotent(req.Method) {
+				return next.Do(req)
+			}
+			return policy.do(next, req)
+		})
+	}
+}
+
+// normalized retorna a política com valores padrão nos campos não configurados
+func (p RetryPolicy) normalized() RetryPolicy {
+	if p.MaxAttempts < 1 {
+		p.MaxAttempts = 1
+	}
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = defaultRetryBaseDelay
+	}
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = defaultRetryMaxDelay
+	}
+	return p
+}
+
+// do executa a requisição repetindo-a em falhas transitórias
+func (p *RetryPolicy) do(next Doer, req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	info, _ := RequestInfoFromContext(ctx)
+
+	for attempt := 1; ; attempt++ {
+		if info != nil {
+			info.Attempt = attempt
+		}
+
+		resp, err := next.Do(req)
+		attemptErr := err
+		if err == nil {
+			body, readErr := readBody(resp)
+			if readErr != nil {
+				return nil, readErr
+			}
+			attemptErr = responseError(resp, body)
+		}
+
+		if attemptErr == nil || attempt >= p.MaxAttempts || !shouldRetry(ctx, attemptErr) {
+			return resp, err
+		}
+
+		delay := p.backoff(attempt)
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+			if wait, ok := retryAfter(resp); ok {
+				delay = min(wait, p.MaxDelay)
+			}
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			return resp, err
+		}
+
+		retry := RetryAttempt{
+			Method:     req.Method,
+			Path:       requestPath(req),
+			Attempt:    attempt,
+			StatusCode: statusCode,
+			Err:        attemptErr,
+			Delay:      delay,
+		}
+		if info != nil && info.logger != nil {
+			logRetry(ctx, info.logger, retry)
+		}
+		if p.OnRetry != nil {
+			p.OnRetry(retry)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, err
+		case <-timer.C:
+		}
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+	}
+}
+
+// backoff calcula o atraso com jitter antes da tentativa seguinte a attempt
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.MaxDelay
+	if shift := attempt - 1; shift < 32 {
+		if d := p.BaseDelay << shift; d > 0 && d < p.MaxDelay {
+			delay = d
+		}
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// isIdempotent informa se o método HTTP pode ser repetido com segurança
+func isIdempotent(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// shouldRetry informa se a falha é transitória e a requisição pode ser repetida
+func shouldRetry(ctx context.Context, err error) bool {
+	return ctx.Err() == nil && IsRetryable(err)
+}
+
+// retryAfter lê o cabeçalho Retry-After de respostas 429 e 503
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	value := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
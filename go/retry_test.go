@@ -0,0 +1,191 @@
+package tabuamare
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func fastRetryPolicy(maxAttempts int) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: maxAttempts,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    10 * time.Millisecond,
+	}
+}
+
+func TestDoRequest_RetrySucceedsAfterTransientFailures(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"data": [], "total": 0}`))
+		}
+	}))
+	defer server.Close()
+
+	var attempts []RetryAttempt
+	policy := fastRetryPolicy(5)
+	policy.OnRetry = func(a RetryAttempt) {
+		attempts = append(attempts, a)
+	}
+
+	client := NewClient(WithBaseURL(server.URL), WithRetryPolicy(policy))
+	if _, err := client.doRequest(context.Background(), "GET", "/test"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Errorf("expected 3 calls, got %d", got)
+	}
+	if len(attempts) != 2 {
+		t.Fatalf("expected 2 retry notifications, got %d", len(attempts))
+	}
+	if attempts[0].StatusCode != http.StatusServiceUnavailable || attempts[0].Attempt != 1 {
+		t.Errorf("unexpected first attempt: %+v", attempts[0])
+	}
+	if attempts[1].Err != ErrRateLimitExceeded || attempts[1].Path != "/test" {
+		t.Errorf("unexpected second attempt: %+v", attempts[1])
+	}
+}
+
+func TestDoRequest_RetryStopsAtMaxAttempts(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithRetryPolicy(fastRetryPolicy(3)))
+	_, err := client.doRequest(context.Background(), "GET", "/test")
+	if !errors.Is(err, ErrRateLimitExceeded) {
+		t.Errorf("expected ErrRateLimitExceeded, got %v", err)
+	}
+	var reqErr *RequestError
+	if !errors.As(err, &reqErr) || reqErr.Attempt != 3 {
+		t.Errorf("expected RequestError after 3 attempts, got %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Errorf("expected 3 calls, got %d", got)
+	}
+}
+
+func TestDoRequest_RetrySkipsClientErrors(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithRetryPolicy(fastRetryPolicy(3)))
+	if _, err := client.doRequest(context.Background(), "GET", "/test"); !IsAPIError(err) {
+		t.Errorf("expected APIError, got %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
+
+// permanentTransportError simula um transporte que sinaliza falhas permanentes
+type permanentTransportError struct{}
+
+func (permanentTransportError) Error() string   { return "no fixture" }
+func (permanentTransportError) Permanent() bool { return true }
+
+type transportFunc func(*http.Request) (*http.Response, error)
+
+func (f transportFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestDoRequest_RetrySkipsPermanentTransportErrors(t *testing.T) {
+	var calls int32
+	transport := transportFunc(func(_ *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, permanentTransportError{}
+	})
+
+	client := NewClient(
+		WithHTTPClient(&http.Client{Transport: transport}),
+		WithRetryPolicy(fastRetryPolicy(3)),
+	)
+	_, err := client.doRequest(context.Background(), "GET", "/test")
+
+	var netErr *NetworkError
+	if err == nil || errors.As(err, &netErr) || IsRetryable(err) {
+		t.Errorf("expected a non-network, non-retryable error, got %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
+
+func TestDoRequest_RetryRespectsDeadline(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	policy := fastRetryPolicy(5)
+	policy.MaxDelay = time.Minute
+
+	client := NewClient(WithBaseURL(server.URL), WithRetryPolicy(policy))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.doRequest(ctx, "GET", "/test")
+	if !errors.Is(err, ErrRateLimitExceeded) {
+		t.Errorf("expected ErrRateLimitExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected to give up before the deadline, took %s", elapsed)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	testCases := []struct {
+		name   string
+		status int
+		header string
+		want   time.Duration
+		ok     bool
+	}{
+		{"seconds on 429", http.StatusTooManyRequests, "3", 3 * time.Second, true},
+		{"seconds on 503", http.StatusServiceUnavailable, "1", time.Second, true},
+		{"ignored on 500", http.StatusInternalServerError, "3", 0, false},
+		{"missing header", http.StatusTooManyRequests, "", 0, false},
+		{"invalid header", http.StatusTooManyRequests, "soon", 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tc.status, Header: http.Header{}}
+			if tc.header != "" {
+				resp.Header.Set("Retry-After", tc.header)
+			}
+
+			got, ok := retryAfter(resp)
+			if ok != tc.ok || got != tc.want {
+				t.Errorf("expected (%s, %v), got (%s, %v)", tc.want, tc.ok, got, ok)
+			}
+		})
+	}
+}
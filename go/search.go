@@ -0,0 +1,194 @@
+package tabuamare
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// minSearchScore é a pontuação mínima para um porto aparecer nos resultados da busca
+const minSearchScore = 0.5
+
+// accentReplacer remove os acentos usados nos nomes dos portos
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// harborNamePrefixes são os prefixos genéricos removidos dos nomes, já normalizados
+var harborNamePrefixes = []string{"porto de ", "porto do ", "porto da ", "porto dos ", "porto das ", "porto "}
+
+// searchStopwords são palavras ignoradas na comparação por tokens
+var searchStopwords = map[string]bool{"de": true, "do": true, "da": true, "dos": true, "das": true, "e": true}
+
+// HarborMatch é um porto encontrado pela busca, com a pontuação de 0 a 1
+type HarborMatch struct {
+	ID    int     `json:"id"`
+	Name  string  `json:"name"`
+	Score float64 `json:"score"`
+}
+
+// NormalizeHarborName normaliza um nome de porto para comparação: remove acentos,
+// converte para minúsculas e descarta o prefixo "PORTO DE" e o sufixo entre parênteses.
+// Ex: "PORTO DE CABEDELO (ESTADO DA PARAÍBA)" vira "cabedelo".
+func NormalizeHarborName(name string) string {
+	normalized := accentReplacer.Replace(strings.ToLower(name))
+
+	if i := strings.Index(normalized, "("); i >= 0 {
+		normalized = normalized[:i]
+	}
+
+	normalized = strings.Join(strings.FieldsFunc(normalized, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+
+	for _, prefix := range harborNamePrefixes {
+		if trimmed := strings.TrimPrefix(normalized, prefix); trimmed != normalized && trimmed != "" {
+			return trimmed
+		}
+	}
+	return normalized
+}
+
+// SearchHarborNames busca portos por um texto livre (ex: "maceio", "cabedelo"),
+// tolerando acentos, maiúsculas e erros de digitação. Retorna até limit resultados,
+// do mais ao menos parecido; limit <= 0 retorna todos os resultados.
+func SearchHarborNames(query string, names []HarborName, limit int) []HarborMatch {
+	candidates := make([]HarborMatch, len(names))
+	for i, name := range names {
+		candidates[i] = HarborMatch{ID: name.ID, Name: name.HarborName}
+	}
+	return rankHarborMatches(query, candidates, limit)
+}
+
+// SearchHarbors busca portos por um texto livre, com as mesmas regras de SearchHarborNames
+func SearchHarbors(query string, harbors []Harbor, limit int) []HarborMatch {
+	candidates := make([]HarborMatch, len(harbors))
+	for i, harbor := range harbors {
+		candidates[i] = HarborMatch{ID: harbor.ID, Name: harbor.HarborName}
+	}
+	return rankHarborMatches(query, candidates, limit)
+}
+
+// Search busca portos do catálogo por um texto livre, com as mesmas regras de SearchHarborNames
+func (c *Catalog) Search(query string, limit int) []HarborMatch {
+	return SearchHarbors(query, c.Harbors(), limit)
+}
+
+// rankHarborMatches pontua os candidatos contra a consulta e os ordena pela pontuação
+func rankHarborMatches(query string, candidates []HarborMatch, limit int) []HarborMatch {
+	queryTokens := searchTokens(NormalizeHarborName(query))
+	if len(queryTokens) == 0 {
+		return nil
+	}
+
+	var matches []HarborMatch
+	for _, candidate := range candidates {
+		score := tokenSimilarity(queryTokens, searchTokens(NormalizeHarborName(candidate.Name)))
+		if score >= minSearchScore {
+			candidate.Score = score
+			matches = append(matches, candidate)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].ID < matches[j].ID
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// searchTokens divide um nome normalizado em tokens, ignorando as stopwords
+func searchTokens(normalized string) []string {
+	var tokens []string
+	for _, token := range strings.Fields(normalized) {
+		if !searchStopwords[token] {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// tokenSimilarity compara cada token da consulta com o token mais parecido do nome.
+// A pontuação combina a cobertura da consulta (peso maior) com a cobertura do nome,
+// para que "rio grande" prefira "RIO GRANDE" a "RIO GRANDE DO NORTE - NATAL".
+func tokenSimilarity(queryTokens, nameTokens []string) float64 {
+	if len(nameTokens) == 0 {
+		return 0
+	}
+
+	var total float64
+	matched := make([]bool, len(nameTokens))
+	for _, queryToken := range queryTokens {
+		best, bestIndex := 0.0, -1
+		for i, nameToken := range nameTokens {
+			if sim := wordSimilarity(queryToken, nameToken); sim > best {
+				best, bestIndex = sim, i
+			}
+		}
+		total += best
+		if best >= minSearchScore {
+			matched[bestIndex] = true
+		}
+	}
+
+	var covered float64
+	for _, ok := range matched {
+		if ok {
+			covered++
+		}
+	}
+
+	queryCoverage := total / float64(len(queryTokens))
+	nameCoverage := covered / float64(len(nameTokens))
+	return 0.9*queryCoverage + 0.1*nameCoverage
+}
+
+// wordSimilarity retorna a semelhança de 0 a 1 entre duas palavras pela distância de
+// Levenshtein. Prefixos com pelo menos 3 letras contam como quase iguais, para
+// consultas incompletas como "cabed".
+func wordSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+
+	ra, rb := []rune(a), []rune(b)
+	similarity := 1 - float64(levenshtein(ra, rb))/float64(max(len(ra), len(rb)))
+	if len(ra) >= 3 && strings.HasPrefix(b, a) {
+		similarity = max(similarity, 0.9)
+	}
+	return similarity
+}
+
+// levenshtein retorna o número mínimo de inserções, remoções e substituições entre a e b
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
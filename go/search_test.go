@@ -0,0 +1,98 @@
+package tabuamare
+
+import "testing"
+
+func searchTestNames() []HarborName {
+	return []HarborName{
+		{ID: 1, HarborName: "PORTO DE MACEIÓ (ESTADO DE ALAGOAS)"},
+		{ID: 2, HarborName: "PORTO DE CABEDELO (ESTADO DA PARAÍBA)"},
+		{ID: 3, HarborName: "PORTO DO RIO GRANDE (ESTADO DO RIO GRANDE DO SUL)"},
+		{ID: 4, HarborName: "PORTO DE SÃO FRANCISCO DO SUL (ESTADO DE SANTA CATARINA)"},
+		{ID: 5, HarborName: "TERMINAL DA ILHA GUAÍBA (ESTADO DO RIO DE JANEIRO)"},
+	}
+}
+
+func TestNormalizeHarborName(t *testing.T) {
+	testCases := []struct {
+		input, want string
+	}{
+		{"PORTO DE CABEDELO (ESTADO DA PARAÍBA)", "cabedelo"},
+		{"PORTO DE MACEIÓ (ESTADO DE ALAGOAS)", "maceio"},
+		{"PORTO DO RIO GRANDE (ESTADO DO RIO GRANDE DO SUL)", "rio grande"},
+		{"TERMINAL DA ILHA GUAÍBA", "terminal da ilha guaiba"},
+		{"  São   Luís-MA ", "sao luis ma"},
+		{"Porto", "porto"},
+	}
+
+	for _, tc := range testCases {
+		if got := NormalizeHarborName(tc.input); got != tc.want {
+			t.Errorf("NormalizeHarborName(%q): expected %q, got %q", tc.input, tc.want, got)
+		}
+	}
+}
+
+func TestSearchHarborNames(t *testing.T) {
+	testCases := []struct {
+		query  string
+		wantID int
+	}{
+		{"maceio", 1},
+		{"Maceió", 1},
+		{"cabedelo", 2},
+		{"cabdelo", 2},
+		{"cabed", 2},
+		{"rio grande", 3},
+		{"sao francisco", 4},
+		{"guaiba", 5},
+	}
+
+	for _, tc := range testCases {
+		matches := SearchHarborNames(tc.query, searchTestNames(), 1)
+		if len(matches) != 1 || matches[0].ID != tc.wantID {
+			t.Errorf("%q: expected harbor %d, got %+v", tc.query, tc.wantID, matches)
+		}
+	}
+}
+
+func TestSearchHarbors_RanksAndFilters(t *testing.T) {
+	if matches := SearchHarborNames("xyzxyz", searchTestNames(), 0); len(matches) != 0 {
+		t.Errorf("expected no matches, got %+v", matches)
+	}
+	if matches := SearchHarborNames("   ", searchTestNames(), 0); matches != nil {
+		t.Errorf("expected no matches for an empty query, got %+v", matches)
+	}
+
+	harbors := testHarbors()
+	matches := SearchHarbors("rio grande", harbors, 0)
+	if len(matches) == 0 || matches[0].ID != 4 || matches[0].Score != 1 {
+		t.Fatalf("expected Rio Grande with a perfect score first, got %+v", matches)
+	}
+	for i := 1; i < len(matches); i++ {
+		if matches[i].Score > matches[i-1].Score {
+			t.Errorf("expected matches sorted by score, got %+v", matches)
+		}
+	}
+
+	catalog := NewCatalog([]CatalogEntry{{Harbor: harbors[2]}, {Harbor: harbors[0]}})
+	if matches := catalog.Search("santos", 0); len(matches) != 1 || matches[0].ID != 3 {
+		t.Errorf("expected Santos from the catalog, got %+v", matches)
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"cabedelo", "cabdelo", 1},
+	}
+
+	for _, tc := range testCases {
+		if got := levenshtein([]rune(tc.a), []rune(tc.b)); got != tc.want {
+			t.Errorf("levenshtein(%q, %q): expected %d, got %d", tc.a, tc.b, tc.want, got)
+		}
+	}
+}
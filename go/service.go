@@ -0,0 +1,196 @@
+package tabuamare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// TideService reúne as consultas básicas da API. *Client implementa a interface, e os
+// decoradores deste arquivo (NewLoggingService, NewCachingService, NewMetricsService,
+// NewFallbackService) envolvem qualquer implementação, podendo ser combinados entre si.
+// Em testes, uma implementação própria dispensa um servidor HTTP.
+type TideService interface {
+	GetStates(ctx context.Context) ([]string, error)
+	GetHarborNames(ctx context.Context, state string) ([]HarborName, error)
+	GetHarbors(ctx context.Context, ids ...int) ([]Harbor, error)
+	GetHarbor(ctx context.Context, id int) (*Harbor, error)
+	GetTideTable(ctx context.Context, harborID, month int, days []int) ([]TideTable, error)
+	GetTideTableForMonth(ctx context.Context, harborID, month int) ([]TideTable, error)
+	GetNearestHarbor(ctx context.Context, lat, lng float64) (*NearestHarbor, error)
+}
+
+var _ TideService = (*Client)(nil)
+
+// ServiceMetrics recebe a duração e o resultado de cada chamada feita por NewMetricsService
+type ServiceMetrics interface {
+	ObserveCall(operation string, duration time.Duration, err error)
+}
+
+// ServiceMetricsFunc adapta uma função para a interface ServiceMetrics
+type ServiceMetricsFunc func(operation string, duration time.Duration, err error)
+
+// ObserveCall chama f(operation, duration, err)
+func (f ServiceMetricsFunc) ObserveCall(operation string, duration time.Duration, err error) {
+	f(operation, duration, err)
+}
+
+// NewLoggingService registra cada chamada em logger: chamadas bem-sucedidas em nível
+// Debug e falhas em nível Warn, com a operação, os argumentos e a duração
+func NewLoggingService(next TideService, logger *slog.Logger) TideService {
+	return &interceptedService{next: next, intercept: func(ctx context.Context, call serviceCall, invoke func(context.Context) (any, error)) (any, error) {
+		start := time.Now()
+		result, err := invoke(ctx)
+
+		attrs := []slog.Attr{
+			slog.String("operation", call.operation),
+			slog.String("call", call.key),
+			slog.Duration("duration", time.Since(start)),
+		}
+		if err != nil {
+			logger.LogAttrs(ctx, slog.LevelWarn, "tabuamare call failed", append(attrs, slog.Any("error", err))...)
+		} else {
+			logger.LogAttrs(ctx, slog.LevelDebug, "tabuamare call", attrs...)
+		}
+		return result, err
+	}}
+}
+
+// NewCachingService guarda em cache, por ttl, os resultados bem-sucedidos de cada
+// chamada, codificados em JSON e indexados pela operação e seus argumentos.
+// Pode reutilizar o mesmo Cache do cliente, pois as chaves não colidem.
+func NewCachingService(next TideService, cache Cache, ttl time.Duration) TideService {
+	return &interceptedService{next: next, intercept: func(ctx context.Context, call serviceCall, invoke func(context.Context) (any, error)) (any, error) {
+		key := "service:" + call.key
+		if data, ok := cache.Get(key); ok {
+			if result, err := call.decode(data); err == nil {
+				return result, nil
+			}
+		}
+
+		result, err := invoke(ctx)
+		if err != nil {
+			return result, err
+		}
+
+		if data, marshalErr := json.Marshal(result); marshalErr == nil {
+			cache.Set(key, data, ttl)
+		}
+		return result, nil
+	}}
+}
+
+// NewMetricsService informa a metrics a duração e o erro de cada chamada
+func NewMetricsService(next TideService, metrics ServiceMetrics) TideService {
+	return &interceptedService{next: next, intercept: func(ctx context.Context, call serviceCall, invoke func(context.Context) (any, error)) (any, error) {
+		start := time.Now()
+		result, err := invoke(ctx)
+		metrics.ObserveCall(call.operation, time.Since(start), err)
+		return result, err
+	}}
+}
+
+// NewFallbackService repete em fallback as chamadas que falharem em primary, desde que
+// o contexto ainda esteja ativo. Se as duas falharem, o erro retornado reúne ambos.
+func NewFallbackService(primary, fallback TideService) TideService {
+	return &interceptedService{next: primary, intercept: func(ctx context.Context, call serviceCall, invoke func(context.Context) (any, error)) (any, error) {
+		result, err := invoke(ctx)
+		if err == nil || ctx.Err() != nil {
+			return result, err
+		}
+
+		fallbackResult, fallbackErr := call.fallback(ctx, fallback)
+		if fallbackErr != nil {
+			return result, errors.Join(err, fallbackErr)
+		}
+		return fallbackResult, nil
+	}}
+}
+
+// serviceCall descreve uma chamada interceptada
+type serviceCall struct {
+	operation string
+	// key identifica a operação e seus argumentos (ex: "GetHarbors:[1 2]")
+	key string
+	// decode converte um resultado serializado em JSON no tipo retornado pela operação
+	decode func(data []byte) (any, error)
+	// fallback executa a mesma chamada em outro TideService
+	fallback func(ctx context.Context, svc TideService) (any, error)
+}
+
+// interceptedService implementa TideService passando cada chamada por intercept
+type interceptedService struct {
+	next      TideService
+	intercept func(ctx context.Context, call serviceCall, invoke func(context.Context) (any, error)) (any, error)
+}
+
+// around monta a serviceCall de uma operação de tipo T e a executa pelo interceptador
+func around[T any](ctx context.Context, s *interceptedService, operation, key string, fn func(ctx context.Context, svc TideService) (T, error)) (T, error) {
+	call := serviceCall{
+		operation: operation,
+		key:       operation + ":" + key,
+		decode: func(data []byte) (any, error) {
+			var value T
+			err := json.Unmarshal(data, &value)
+			return value, err
+		},
+		fallback: func(ctx context.Context, svc TideService) (any, error) {
+			return fn(ctx, svc)
+		},
+	}
+
+	result, err := s.intercept(ctx, call, func(ctx context.Context) (any, error) {
+		return fn(ctx, s.next)
+	})
+
+	value, _ := result.(T)
+	return value, err
+}
+
+func (s *interceptedService) GetStates(ctx context.Context) ([]string, error) {
+	return around(ctx, s, "GetStates", "", func(ctx context.Context, svc TideService) ([]string, error) {
+		return svc.GetStates(ctx)
+	})
+}
+
+func (s *interceptedService) GetHarborNames(ctx context.Context, state string) ([]HarborName, error) {
+	return around(ctx, s, "GetHarborNames", state, func(ctx context.Context, svc TideService) ([]HarborName, error) {
+		return svc.GetHarborNames(ctx, state)
+	})
+}
+
+func (s *interceptedService) GetHarbors(ctx context.Context, ids ...int) ([]Harbor, error) {
+	return around(ctx, s, "GetHarbors", fmt.Sprint(ids), func(ctx context.Context, svc TideService) ([]Harbor, error) {
+		return svc.GetHarbors(ctx, ids...)
+	})
+}
+
+func (s *interceptedService) GetHarbor(ctx context.Context, id int) (*Harbor, error) {
+	return around(ctx, s, "GetHarbor", fmt.Sprint(id), func(ctx context.Context, svc TideService) (*Harbor, error) {
+		return svc.GetHarbor(ctx, id)
+	})
+}
+
+func (s *interceptedService) GetTideTable(ctx context.Context, harborID, month int, days []int) ([]TideTable, error) {
+	key := fmt.Sprintf("%d/%d/%v", harborID, month, days)
+	return around(ctx, s, "GetTideTable", key, func(ctx context.Context, svc TideService) ([]TideTable, error) {
+		return svc.GetTideTable(ctx, harborID, month, days)
+	})
+}
+
+func (s *interceptedService) GetTideTableForMonth(ctx context.Context, harborID, month int) ([]TideTable, error) {
+	key := fmt.Sprintf("%d/%d", harborID, month)
+	return around(ctx, s, "GetTideTableForMonth", key, func(ctx context.Context, svc TideService) ([]TideTable, error) {
+		return svc.GetTideTableForMonth(ctx, harborID, month)
+	})
+}
+
+func (s *interceptedService) GetNearestHarbor(ctx context.Context, lat, lng float64) (*NearestHarbor, error) {
+	key := fmt.Sprintf("%.6f,%.6f", lat, lng)
+	return around(ctx, s, "GetNearestHarbor", key, func(ctx context.Context, svc TideService) (*NearestHarbor, error) {
+		return svc.GetNearestHarbor(ctx, lat, lng)
+	})
+}
@@ -0,0 +1,177 @@
+package tabuamare
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// stubService é um TideService em memória que conta as chamadas recebidas
+type stubService struct {
+	mu    sync.Mutex
+	calls map[string]int
+	err   error
+}
+
+func (s *stubService) record(operation string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.calls == nil {
+		s.calls = make(map[string]int)
+	}
+	s.calls[operation]++
+	return s.err
+}
+
+func (s *stubService) count(operation string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[operation]
+}
+
+func (s *stubService) GetStates(_ context.Context) ([]string, error) {
+	if err := s.record("GetStates"); err != nil {
+		return nil, err
+	}
+	return []string{"al", "pe"}, nil
+}
+
+func (s *stubService) GetHarborNames(_ context.Context, _ string) ([]HarborName, error) {
+	if err := s.record("GetHarborNames"); err != nil {
+		return nil, err
+	}
+	return []HarborName{{ID: 1, HarborName: "PORTO DE MACEIÓ"}}, nil
+}
+
+func (s *stubService) GetHarbors(_ context.Context, ids ...int) ([]Harbor, error) {
+	if err := s.record("GetHarbors"); err != nil {
+		return nil, err
+	}
+	harbors := make([]Harbor, len(ids))
+	for i, id := range ids {
+		harbors[i] = Harbor{ID: id}
+	}
+	return harbors, nil
+}
+
+func (s *stubService) GetHarbor(_ context.Context, id int) (*Harbor, error) {
+	if err := s.record("GetHarbor"); err != nil {
+		return nil, err
+	}
+	return &Harbor{ID: id, HarborName: "PORTO DE TESTE"}, nil
+}
+
+func (s *stubService) GetTideTable(_ context.Context, _, _ int, _ []int) ([]TideTable, error) {
+	if err := s.record("GetTideTable"); err != nil {
+		return nil, err
+	}
+	return []TideTable{{Year: 2025}}, nil
+}
+
+func (s *stubService) GetTideTableForMonth(_ context.Context, _, _ int) ([]TideTable, error) {
+	if err := s.record("GetTideTableForMonth"); err != nil {
+		return nil, err
+	}
+	return []TideTable{{Year: 2025}}, nil
+}
+
+func (s *stubService) GetNearestHarbor(_ context.Context, _, _ float64) (*NearestHarbor, error) {
+	if err := s.record("GetNearestHarbor"); err != nil {
+		return nil, err
+	}
+	return &NearestHarbor{Harbor: Harbor{ID: 3}, Distance: 10}, nil
+}
+
+func TestCachingService(t *testing.T) {
+	stub := &stubService{}
+	svc := NewCachingService(stub, NewMemoryCache(0), time.Minute)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		harbor, err := svc.GetHarbor(ctx, 7)
+		if err != nil || harbor == nil || harbor.ID != 7 || harbor.HarborName != "PORTO DE TESTE" {
+			t.Fatalf("unexpected result: %+v (%v)", harbor, err)
+		}
+	}
+	if got := stub.count("GetHarbor"); got != 1 {
+		t.Errorf("expected 1 call to the wrapped service, got %d", got)
+	}
+
+	if _, err := svc.GetHarbor(ctx, 8); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := stub.count("GetHarbor"); got != 2 {
+		t.Errorf("expected a different argument to miss the cache, got %d calls", got)
+	}
+
+	stub.err = errors.New("boom")
+	if _, err := svc.GetStates(ctx); err == nil {
+		t.Fatal("expected error")
+	}
+	stub.err = nil
+	if states, err := svc.GetStates(ctx); err != nil || len(states) != 2 {
+		t.Errorf("expected errors not to be cached, got %v (%v)", states, err)
+	}
+}
+
+func TestFallbackService(t *testing.T) {
+	primary := &stubService{err: &APIError{Status: 503}}
+	fallback := &stubService{}
+	svc := NewFallbackService(primary, fallback)
+
+	harbor, err := svc.GetNearestHarbor(context.Background(), -23.5, -46.6)
+	if err != nil || harbor.ID != 3 {
+		t.Fatalf("expected the fallback result, got %+v (%v)", harbor, err)
+	}
+	if primary.count("GetNearestHarbor") != 1 || fallback.count("GetNearestHarbor") != 1 {
+		t.Error("expected one call to each service")
+	}
+
+	fallback.err = errors.New("offline")
+	_, err = svc.GetHarbors(context.Background(), 1, 2)
+	if !errors.Is(err, ErrServerError) || !strings.Contains(err.Error(), "offline") {
+		t.Errorf("expected both errors to be reported, got %v", err)
+	}
+}
+
+func TestMetricsAndLoggingServices(t *testing.T) {
+	var observed []string
+	metrics := ServiceMetricsFunc(func(operation string, _ time.Duration, err error) {
+		observed = append(observed, operation)
+		if operation == "GetTideTable" && err == nil {
+			t.Error("expected the failure to be observed")
+		}
+	})
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	stub := &stubService{}
+	svc := NewLoggingService(NewMetricsService(stub, metrics), logger)
+	ctx := context.Background()
+
+	if _, err := svc.GetTideTableForMonth(ctx, 1, 2); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	stub.err = errors.New("boom")
+	if _, err := svc.GetTideTable(ctx, 1, 2, []int{1, 2}); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if len(observed) != 2 || observed[0] != "GetTideTableForMonth" || observed[1] != "GetTideTable" {
+		t.Errorf("unexpected observed operations: %v", observed)
+	}
+
+	output := logs.String()
+	if !strings.Contains(output, "level=DEBUG") || !strings.Contains(output, "call=GetTideTableForMonth:1/2") {
+		t.Errorf("expected a debug record for the successful call, got:\n%s", output)
+	}
+	if !strings.Contains(output, "level=WARN") || !strings.Contains(output, "error=boom") {
+		t.Errorf("expected a warning for the failed call, got:\n%s", output)
+	}
+}
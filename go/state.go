@@ -0,0 +1,154 @@
+package tabuamare
+
+import (
+	"fmt"
+	"strings"
+)
+
+// State é a sigla de um estado costeiro brasileiro, em minúsculas como na API (ex: "pe")
+type State string
+
+// Estados costeiros atendidos pela API, de norte a sul
+const (
+	StateAP State = "ap"
+	StatePA State = "pa"
+	StateMA State = "ma"
+	StatePI State = "pi"
+	StateCE State = "ce"
+	StateRN State = "rn"
+	StatePB State = "pb"
+	StatePE State = "pe"
+	StateAL State = "al"
+	StateSE State = "se"
+	StateBA State = "ba"
+	StateES State = "es"
+	StateRJ State = "rj"
+	StateSP State = "sp"
+	StatePR State = "pr"
+	StateSC State = "sc"
+	StateRS State = "rs"
+)
+
+// Region é a região geográfica de um estado
+type Region string
+
+// Regiões com estados costeiros
+const (
+	RegionNorte    Region = "Norte"
+	RegionNordeste Region = "Nordeste"
+	RegionSudeste  Region = "Sudeste"
+	RegionSul      Region = "Sul"
+)
+
+// BoundingBox é um retângulo de coordenadas em graus decimais
+type BoundingBox struct {
+	MinLat float64 `json:"min_lat"`
+	MinLng float64 `json:"min_lng"`
+	MaxLat float64 `json:"max_lat"`
+	MaxLng float64 `json:"max_lng"`
+}
+
+// Contains verifica se a coordenada está dentro do retângulo
+func (b BoundingBox) Contains(lat, lng float64) bool {
+	return lat >= b.MinLat && lat <= b.MaxLat && lng >= b.MinLng && lng <= b.MaxLng
+}
+
+type stateInfo struct {
+	name   string
+	region Region
+	// zonas IANA candidatas, em ordem de preferência
+	timezones []string
+	// retângulo aproximado, incluindo ilhas oceânicas (Atol das Rocas, Noronha, Trindade)
+	bounds BoundingBox
+}
+
+// allStates lista os estados costeiros de norte a sul
+var allStates = []State{
+	StateAP, StatePA, StateMA, StatePI, StateCE, StateRN, StatePB, StatePE, StateAL,
+	StateSE, StateBA, StateES, StateRJ, StateSP, StatePR, StateSC, StateRS,
+}
+
+var stateInfos = map[State]stateInfo{
+	StateAP: {"Amapá", RegionNorte, []string{"America/Belem"}, BoundingBox{-1.3, -54.9, 4.5, -49.8}},
+	StatePA: {"Pará", RegionNorte, []string{"America/Belem"}, BoundingBox{-9.9, -58.9, 2.6, -46.0}},
+	StateMA: {"Maranhão", RegionNordeste, []string{"America/Fortaleza"}, BoundingBox{-10.3, -48.8, -1.0, -41.8}},
+	StatePI: {"Piauí", RegionNordeste, []string{"America/Fortaleza"}, BoundingBox{-11.0, -46.0, -2.7, -40.4}},
+	StateCE: {"Ceará", RegionNordeste, []string{"America/Fortaleza"}, BoundingBox{-7.9, -41.5, -2.7, -37.2}},
+	StateRN: {"Rio Grande do Norte", RegionNordeste, []string{"America/Fortaleza", "America/Noronha"}, BoundingBox{-7.0, -38.6, -3.8, -33.7}},
+	StatePB: {"Paraíba", RegionNordeste, []string{"America/Fortaleza"}, BoundingBox{-8.3, -38.8, -6.0, -34.7}},
+	StatePE: {"Pernambuco", RegionNordeste, []string{"America/Recife", "America/Noronha"}, BoundingBox{-9.5, -41.4, -3.8, -32.3}},
+	StateAL: {"Alagoas", RegionNordeste, []string{"America/Maceio"}, BoundingBox{-10.5, -38.3, -8.8, -35.1}},
+	StateSE: {"Sergipe", RegionNordeste, []string{"America/Maceio"}, BoundingBox{-11.6, -38.3, -9.5, -36.4}},
+	StateBA: {"Bahia", RegionNordeste, []string{"America/Bahia"}, BoundingBox{-18.4, -46.7, -8.5, -37.3}},
+	StateES: {"Espírito Santo", RegionSudeste, []string{"America/Sao_Paulo", "America/Noronha"}, BoundingBox{-21.3, -41.9, -17.9, -28.8}},
+	StateRJ: {"Rio de Janeiro", RegionSudeste, []string{"America/Sao_Paulo"}, BoundingBox{-23.4, -44.9, -20.7, -40.9}},
+	StateSP: {"São Paulo", RegionSudeste, []string{"America/Sao_Paulo"}, BoundingBox{-25.4, -53.1, -19.8, -44.1}},
+	StatePR: {"Paraná", RegionSul, []string{"America/Sao_Paulo"}, BoundingBox{-26.7, -54.7, -22.5, -48.0}},
+	StateSC: {"Santa Catarina", RegionSul, []string{"America/Sao_Paulo"}, BoundingBox{-29.4, -53.9, -25.9, -48.3}},
+	StateRS: {"Rio Grande do Sul", RegionSul, []string{"America/Sao_Paulo"}, BoundingBox{-33.8, -57.7, -27.0, -49.7}},
+}
+
+// States retorna todos os estados costeiros, de norte a sul
+func States() []State {
+	return append([]State(nil), allStates...)
+}
+
+// ParseState interpreta a sigla (ex: "PE") ou o nome completo (ex: "Pernambuco",
+// "sao paulo") de um estado costeiro, sem diferenciar maiúsculas e acentos.
+// Retorna um erro que envolve ErrInvalidState se o estado não for reconhecido.
+func ParseState(s string) (State, error) {
+	value := strings.ToLower(strings.TrimSpace(s))
+	if state := State(value); state.Valid() {
+		return state, nil
+	}
+
+	name := accentReplacer.Replace(value)
+	for _, state := range allStates {
+		if accentReplacer.Replace(strings.ToLower(stateInfos[state].name)) == name {
+			return state, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrInvalidState, s)
+}
+
+// Valid verifica se o estado é um dos estados costeiros conhecidos
+func (s State) Valid() bool {
+	_, ok := stateInfos[s]
+	return ok
+}
+
+// Code retorna a sigla do estado em maiúsculas (ex: "PE")
+func (s State) Code() string {
+	return strings.ToUpper(string(s))
+}
+
+// Name retorna o nome completo do estado em português (ex: "Pernambuco")
+func (s State) Name() string {
+	return stateInfos[s].name
+}
+
+// Region retorna a região do estado
+func (s State) Region() Region {
+	return stateInfos[s].region
+}
+
+// Timezones retorna as zonas IANA usadas no estado, da principal para as ilhas oceânicas
+func (s State) Timezones() []string {
+	return append([]string(nil), stateInfos[s].timezones...)
+}
+
+// Bounds retorna um retângulo aproximado que contém o estado e suas ilhas
+func (s State) Bounds() BoundingBox {
+	return stateInfos[s].bounds
+}
+
+// UnmarshalText interpreta o estado com ParseState, validando valores vindos de JSON ou configuração
+func (s *State) UnmarshalText(text []byte) error {
+	state, err := ParseState(string(text))
+	if err != nil {
+		return err
+	}
+	*s = state
+	return nil
+}
@@ -0,0 +1,129 @@
+package tabuamare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParseState(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  State
+	}{
+		{"pe", StatePE},
+		{" SP ", StateSP},
+		{"Pernambuco", StatePE},
+		{"sao paulo", StateSP},
+		{"ESPÍRITO SANTO", StateES},
+	}
+
+	for _, tc := range testCases {
+		got, err := ParseState(tc.input)
+		if err != nil || got != tc.want {
+			t.Errorf("ParseState(%q): expected %s, got %s (%v)", tc.input, tc.want, got, err)
+		}
+	}
+
+	for _, input := range []string{"", "xx", "mg", "Minas Gerais"} {
+		if _, err := ParseState(input); !errors.Is(err, ErrInvalidState) {
+			t.Errorf("ParseState(%q): expected ErrInvalidState, got %v", input, err)
+		}
+	}
+}
+
+func TestStateMetadata(t *testing.T) {
+	states := States()
+	if len(states) != 17 {
+		t.Fatalf("expected 17 coastal states, got %d", len(states))
+	}
+
+	for _, state := range states {
+		if state.Name() == "" || state.Region() == "" || len(state.Timezones()) == 0 {
+			t.Errorf("%s: missing metadata", state.Code())
+		}
+		bounds := state.Bounds()
+		if bounds.MinLat >= bounds.MaxLat || bounds.MinLng >= bounds.MaxLng {
+			t.Errorf("%s: invalid bounding box %+v", state.Code(), bounds)
+		}
+	}
+
+	for _, harbor := range testHarbors()[:4] {
+		lat, lng, err := harbor.Coordinates()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if state := State(harbor.State); !state.Bounds().Contains(lat, lng) {
+			t.Errorf("expected %s to contain %s", state.Name(), harbor.HarborName)
+		}
+	}
+
+	if StatePE.Name() != "Pernambuco" || StatePE.Region() != RegionNordeste || StatePE.Code() != "PE" {
+		t.Errorf("unexpected metadata for PE: %s %s %s", StatePE.Name(), StatePE.Region(), StatePE.Code())
+	}
+	if State("mg").Valid() {
+		t.Error("expected MG to be invalid")
+	}
+}
+
+func TestState_UnmarshalJSON(t *testing.T) {
+	var config struct {
+		State State `json:"state"`
+	}
+	if err := json.Unmarshal([]byte(`{"state":"Alagoas"}`), &config); err != nil || config.State != StateAL {
+		t.Errorf("expected AL, got %s (%v)", config.State, err)
+	}
+	if err := json.Unmarshal([]byte(`{"state":"zz"}`), &config); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("expected ErrInvalidState, got %v", err)
+	}
+}
+
+func TestGetHarborNames_ValidatesStateLocally(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Path != "/harbor_names/pb" {
+			t.Errorf("expected path /harbor_names/pb, got %s", r.URL.Path)
+		}
+		_ = json.NewEncoder(w).Encode(HarborNamesResponse{Data: []HarborName{{ID: 1}}, Total: 1})
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+
+	if _, err := client.GetHarborNames(context.Background(), "XX"); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("expected ErrInvalidState, got %v", err)
+	}
+	if atomic.LoadInt32(&requests) != 0 {
+		t.Error("expected no request for an invalid state")
+	}
+
+	var valErr *ValidationError
+	if _, err := client.GetHarborNames(context.Background(), ""); !errors.As(err, &valErr) {
+		t.Errorf("expected ValidationError for an empty state, got %v", err)
+	}
+
+	if _, err := client.GetHarborNames(context.Background(), "Paraíba"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestGetTypedStates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(StatesResponse{Data: []string{"AL", "pe"}, Total: 2})
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	states, err := client.GetTypedStates(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(states) != 2 || states[0] != StateAL || states[1] != StatePE {
+		t.Errorf("expected [al pe], got %v", states)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // GetStates retorna a lista de todos os estados costeiros brasileiros disponíveis
@@ -24,3 +25,19 @@ func (c *Client) GetStates(ctx context.Context) ([]string, error) {
 
 	return response.Data, nil
 }
+
+// GetTypedStates retorna os estados disponíveis na API como State. Estados que a
+// API passe a retornar e que ainda não sejam conhecidos pelo SDK são mantidos,
+// mas State.Valid retorna false para eles.
+func (c *Client) GetTypedStates(ctx context.Context) ([]State, error) {
+	codes, err := c.GetStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]State, len(codes))
+	for i, code := range codes {
+		states[i] = State(strings.ToLower(strings.TrimSpace(code)))
+	}
+	return states, nil
+}
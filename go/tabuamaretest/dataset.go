@@ -0,0 +1,159 @@
+package tabuamaretest
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	tabuamare "github.com/Ddiidev/sdks-tabua-mare/go"
+)
+
+// defaultYear é o ano das marés geradas por DefaultDataset
+const defaultYear = 2025
+
+// semidiurnalPeriod é o período médio da maré semidiurna (12h25min)
+const semidiurnalPeriod = 12*time.Hour + 25*time.Minute
+
+// springNeapPeriod é o período aproximado do ciclo de sizígia e quadratura
+const springNeapPeriod = 14.77 * 24 * float64(time.Hour)
+
+var monthNames = [...]string{
+	"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+	"julho", "agosto", "setembro", "outubro", "novembro", "dezembro",
+}
+
+var weekdayNames = [...]string{
+	"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado",
+}
+
+// Dataset é o conjunto de dados servido pelo Server
+type Dataset struct {
+	// Year é o ano informado nas tábuas de marés
+	Year int
+	// Harbors são os portos disponíveis, com estado, ano e instituição de coleta
+	Harbors []tabuamare.CatalogEntry
+	// Tides guarda os meses de marés de cada porto, pelo ID. Portos sem marés
+	// respondem com uma lista vazia em /tabua-mare.
+	Tides map[int][]tabuamare.TideMonth
+}
+
+// DefaultDataset retorna um conjunto sintético com seis portos de estados diferentes
+// e marés semidiurnas para todos os dias de 2025. Os níveis são gerados, não são
+// previsões reais.
+func DefaultDataset() *Dataset {
+	harbors := []tabuamare.CatalogEntry{
+		harborEntry(1, "PORTO DE MACEIÓ (ESTADO DE ALAGOAS)", "al", -9.683333, -35.716667, 1.23),
+		harborEntry(2, "PORTO DO RECIFE (ESTADO DE PERNAMBUCO)", "pe", -8.05, -34.866667, 1.13),
+		harborEntry(3, "PORTO DE CABEDELO (ESTADO DA PARAÍBA)", "pb", -6.966667, -34.833333, 1.33),
+		harborEntry(4, "PORTO DE SALVADOR (ESTADO DA BAHIA)", "ba", -12.966667, -38.516667, 1.37),
+		harborEntry(5, "PORTO DE SANTOS (ESTADO DE SÃO PAULO)", "sp", -23.95, -46.333333, 0.76),
+		harborEntry(6, "PORTO DE RIO GRANDE (ESTADO DO RIO GRANDE DO SUL)", "rs", -32.033333, -52.1, 0.3),
+	}
+	return NewDataset(defaultYear, harbors)
+}
+
+// NewDataset cria um conjunto com os portos informados e marés sintéticas para o ano,
+// geradas por SyntheticTides a partir do nível médio de cada porto
+func NewDataset(year int, harbors []tabuamare.CatalogEntry) *Dataset {
+	dataset := &Dataset{
+		Year:    year,
+		Harbors: harbors,
+		Tides:   make(map[int][]tabuamare.TideMonth, len(harbors)),
+	}
+	for _, harbor := range harbors {
+		dataset.Tides[harbor.ID] = SyntheticTides(year, harbor.ID, harbor.MeanLevel)
+	}
+	return dataset
+}
+
+// DatasetFromCatalog cria um conjunto com os portos de um catálogo (por exemplo, um
+// snapshot salvo de LoadCatalog) e marés sintéticas para o ano
+func DatasetFromCatalog(catalog *tabuamare.Catalog, year int) *Dataset {
+	return NewDataset(year, catalog.Entries())
+}
+
+// SyntheticTides gera quatro extremos de maré por dia (duas preamares e duas
+// baixa-mares) para todos os dias do ano, em torno de meanLevel. A fase varia com
+// seed, para que portos diferentes tenham horários diferentes.
+func SyntheticTides(year, seed int, meanLevel float64) []tabuamare.TideMonth {
+	zone := time.FixedZone("UTC-03:00", -3*60*60)
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, zone)
+	end := start.AddDate(1, 0, 0)
+
+	months := make([]tabuamare.TideMonth, 12)
+	for i := range months {
+		month := time.Month(i + 1)
+		months[i] = tabuamare.TideMonth{MonthName: monthNames[i], Month: i + 1}
+		for day := 1; day <= tabuamare.DaysInMonth(year, i+1); day++ {
+			date := time.Date(year, month, day, 0, 0, 0, 0, zone)
+			months[i].Days = append(months[i].Days, tabuamare.TideDay{
+				WeekdayName: weekdayNames[date.Weekday()],
+				Day:         day,
+				Hours:       []tabuamare.TideHour{},
+			})
+		}
+	}
+
+	halfPeriod := semidiurnalPeriod / 2
+	offset := time.Duration(seed*37%int(semidiurnalPeriod/time.Minute)) * time.Minute
+	high := seed%2 == 0
+	for t := start.Add(offset % halfPeriod); t.Before(end); t = t.Add(halfPeriod) {
+		amplitude := 0.8 + 0.3*math.Cos(2*math.Pi*float64(t.Sub(start))/springNeapPeriod)
+		level := meanLevel - amplitude
+		if high {
+			level = meanLevel + amplitude
+		}
+		high = !high
+
+		day := &months[t.Month()-1].Days[t.Day()-1]
+		day.Hours = append(day.Hours, tabuamare.TideHour{
+			Hour:  t.Format("15:04:05"),
+			Level: math.Round(level*10) / 10,
+		})
+	}
+
+	return months
+}
+
+// harborEntry monta uma entrada do catálogo com as coordenadas nos formatos da API
+func harborEntry(id int, name, state string, lat, lng, meanLevel float64) tabuamare.CatalogEntry {
+	latDirection, lngDirection := "N", "E"
+	if lat < 0 {
+		latDirection = "S"
+	}
+	if lng < 0 {
+		lngDirection = "W"
+	}
+
+	return tabuamare.CatalogEntry{
+		Harbor: tabuamare.Harbor{
+			ID:         id,
+			HarborName: name,
+			State:      state,
+			Timezone:   "UTC -03.0",
+			Card:       fmt.Sprintf("%05d", 30000+id),
+			GeoLocation: []tabuamare.GeoLocation{{
+				Lat:          fmt.Sprintf("%.6f", math.Abs(lat)),
+				Lng:          fmt.Sprintf("%.6f", math.Abs(lng)),
+				DecimalLat:   formatDMS(lat, latDirection),
+				DecimalLng:   formatDMS(lng, lngDirection),
+				LatDirection: latDirection,
+				LngDirection: lngDirection,
+			}},
+			MeanLevel: meanLevel,
+		},
+		Year:                      defaultYear,
+		DataCollectionInstitution: "Marinha do Brasil - CHM",
+	}
+}
+
+// formatDMS formata uma coordenada em graus e minutos (ex: "9° 41' S")
+func formatDMS(value float64, direction string) string {
+	value = math.Abs(value)
+	degrees := math.Floor(value)
+	minutes := math.Round((value - degrees) * 60)
+	if minutes == 60 {
+		degrees, minutes = degrees+1, 0
+	}
+	return fmt.Sprintf("%.0f° %02.0f' %s", degrees, minutes, direction)
+}
@@ -0,0 +1,302 @@
+// Package tabuamaretest fornece um servidor falso da API Tábua de Marés para testes,
+// sem acesso à rede. O servidor implementa todas as rotas usadas pelo SDK a partir de
+// um Dataset configurável e permite injetar falhas (status 429 e 5xx, latência e JSON
+// malformado) para exercitar novas tentativas, timeouts e tratamento de erros.
+package tabuamaretest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	tabuamare "github.com/Ddiidev/sdks-tabua-mare/go"
+)
+
+// Fault descreve uma falha injetada nas respostas do Server
+type Fault struct {
+	// Path restringe a falha às rotas com esse prefixo (ex: "/tabua-mare/"); vazio afeta todas
+	Path string
+	// Status é o código HTTP retornado (ex: 429, 503); 0 mantém a resposta normal
+	Status int
+	// RetryAfter define o cabeçalho Retry-After, em segundos inteiros
+	RetryAfter time.Duration
+	// Latency atrasa a resposta, respeitando o cancelamento da requisição
+	Latency time.Duration
+	// MalformedJSON substitui o corpo da resposta por um JSON inválido
+	MalformedJSON bool
+	// Times limita quantas requisições a falha afeta; 0 afeta todas
+	Times int
+}
+
+// Server é um servidor HTTP local que responde como a API Tábua de Marés
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	catalog  *tabuamare.Catalog
+	year     int
+	tides    map[int][]tabuamare.TideMonth
+	index    *tabuamare.HarborIndex
+	faults   []*Fault
+	requests []string
+}
+
+// NewServer inicia um servidor com os dados de dataset, ou com DefaultDataset se for nil.
+// Chame Close ao final do teste.
+func NewServer(dataset *Dataset) *Server {
+	if dataset == nil {
+		dataset = DefaultDataset()
+	}
+
+	catalog := tabuamare.NewCatalog(dataset.Harbors)
+	s := &Server{
+		catalog: catalog,
+		year:    dataset.Year,
+		tides:   dataset.Tides,
+		index:   tabuamare.NewHarborIndex(catalog.Harbors()),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewClient cria um cliente apontado para o servidor; opts são aplicadas em seguida
+func (s *Server) NewClient(opts ...tabuamare.ClientOption) *tabuamare.Client {
+	return tabuamare.NewClient(append([]tabuamare.ClientOption{tabuamare.WithBaseURL(s.URL)}, opts...)...)
+}
+
+// InjectFault adiciona uma falha. As falhas são avaliadas na ordem em que foram
+// adicionadas, e apenas a primeira que corresponder à rota é aplicada.
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults remove todas as falhas injetadas
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests retorna os caminhos recebidos pelo servidor, em ordem de chegada
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	fault := s.record(r.URL.Path)
+
+	if fault != nil && fault.Latency > 0 {
+		timer := time.NewTimer(fault.Latency)
+		select {
+		case <-r.Context().Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+
+	if fault != nil && fault.Status != 0 {
+		if fault.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(fault.RetryAfter/time.Second)))
+		}
+		writeError(w, fault.Status, http.StatusText(fault.Status))
+		return
+	}
+
+	if fault != nil && fault.MalformedJSON {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [`))
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	switch {
+	case path == "/states":
+		s.handleStates(w)
+	case strings.HasPrefix(path, "/harbor_names/"):
+		s.handleHarborNames(w, strings.TrimPrefix(path, "/harbor_names/"))
+	case strings.HasPrefix(path, "/harbors/"):
+		s.handleHarbors(w, strings.TrimPrefix(path, "/harbors/"))
+	case strings.HasPrefix(path, "/tabua-mare/"):
+		s.handleTideTable(w, strings.TrimPrefix(path, "/tabua-mare/"))
+	case strings.HasPrefix(path, "/nearest-harbor-independent-state/"):
+		s.handleNearestHarbor(w, strings.TrimPrefix(path, "/nearest-harbor-independent-state/"))
+	default:
+		writeError(w, http.StatusNotFound, "route not found")
+	}
+}
+
+// record registra a requisição e retorna a falha a aplicar, se houver
+func (s *Server) record(path string) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, path)
+
+	for i, fault := range s.faults {
+		if !strings.HasPrefix(path, fault.Path) {
+			continue
+		}
+
+		applied := *fault
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return &applied
+	}
+	return nil
+}
+
+func (s *Server) handleStates(w http.ResponseWriter) {
+	states := s.catalog.States()
+	writeJSON(w, tabuamare.StatesResponse{Data: states, Total: len(states)})
+}
+
+func (s *Server) handleHarborNames(w http.ResponseWriter, rawState string) {
+	state, err := tabuamare.ParseState(rawState)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	names := []tabuamare.HarborName{}
+	for _, entry := range s.catalog.ByState(string(state)) {
+		names = append(names, tabuamare.HarborName{
+			ID:                        entry.ID,
+			Year:                      entry.Year,
+			HarborName:                entry.HarborName,
+			DataCollectionInstitution: entry.DataCollectionInstitution,
+		})
+	}
+	writeJSON(w, tabuamare.HarborNamesResponse{Data: names, Total: len(names)})
+}
+
+func (s *Server) handleHarbors(w http.ResponseWriter, rawIDs string) {
+	harbors := []tabuamare.Harbor{}
+	for _, raw := range strings.Split(rawIDs, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || id <= 0 {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid harbor ID %q", raw))
+			return
+		}
+		if entry, ok := s.catalog.ByID(id); ok {
+			harbors = append(harbors, entry.Harbor)
+		}
+	}
+	writeJSON(w, tabuamare.HarborsResponse{Data: harbors, Total: len(harbors)})
+}
+
+func (s *Server) handleTideTable(w http.ResponseWriter, rest string) {
+	parts := strings.SplitN(rest, "/", 3)
+	if len(parts) != 3 {
+		writeError(w, http.StatusNotFound, "route not found")
+		return
+	}
+
+	id, err := strconv.Atoi(parts[0])
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid harbor ID %q", parts[0]))
+		return
+	}
+
+	month, err := strconv.Atoi(parts[1])
+	if err != nil || month < 1 || month > 12 {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid month %q", parts[1]))
+		return
+	}
+
+	days, err := tabuamare.ParseDayRange(parts[2])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entry, ok := s.catalog.ByID(id)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("harbor %d not found", id))
+		return
+	}
+
+	tables := []tabuamare.TideTable{}
+	for _, tideMonth := range s.tides[id] {
+		if tideMonth.Month != month {
+			continue
+		}
+
+		selected := tideMonth
+		selected.Days = nil
+		for _, day := range tideMonth.Days {
+			if days.Contains(day.Day) {
+				selected.Days = append(selected.Days, day)
+			}
+		}
+
+		tables = append(tables, tabuamare.TideTable{
+			Year:                      s.year,
+			HarborName:                entry.HarborName,
+			State:                     entry.State,
+			Timezone:                  entry.Timezone,
+			Card:                      entry.Card,
+			DataCollectionInstitution: entry.DataCollectionInstitution,
+			MeanLevel:                 entry.MeanLevel,
+			Months:                    []tabuamare.TideMonth{selected},
+		})
+	}
+	writeJSON(w, tabuamare.TideTableResponse{Data: tables, Total: len(tables)})
+}
+
+func (s *Server) handleNearestHarbor(w http.ResponseWriter, rawLatLng string) {
+	parts := strings.Split(rawLatLng, ",")
+	if len(parts) != 2 {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid coordinates %q", rawLatLng))
+		return
+	}
+
+	lat, latErr := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	lng, lngErr := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if latErr != nil || lngErr != nil || lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid coordinates %q", rawLatLng))
+		return
+	}
+
+	nearest := s.index.Nearest(lat, lng, 1)
+	for i := range nearest {
+		// a API informa apenas a distância
+		nearest[i].Bearing, nearest[i].Direction = 0, ""
+		nearest[i].Distance = math.Round(nearest[i].Distance*100) / 100
+	}
+	writeJSON(w, tabuamare.NearestHarborResponse{Data: nearest, Total: len(nearest)})
+}
+
+func writeJSON(w http.ResponseWriter, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// writeError responde com o status e um corpo no formato de erro da API
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(struct {
+		Code    int    `json:"code"`
+		Message string `json:"msg"`
+	}{status, message})
+}
@@ -0,0 +1,136 @@
+package tabuamaretest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	tabuamare "github.com/Ddiidev/sdks-tabua-mare/go"
+)
+
+func TestServer_Routes(t *testing.T) {
+	server := NewServer(nil)
+	defer server.Close()
+
+	client := server.NewClient()
+	ctx := context.Background()
+
+	states, err := client.GetStates(ctx)
+	if err != nil || len(states) != 6 {
+		t.Fatalf("expected 6 states, got %v (%v)", states, err)
+	}
+
+	names, err := client.GetHarborNames(ctx, "PE")
+	if err != nil || len(names) != 1 || names[0].ID != 2 {
+		t.Fatalf("expected Recife, got %+v (%v)", names, err)
+	}
+
+	harbors, err := client.GetHarbors(ctx, 1, 5, 99)
+	if err != nil || len(harbors) != 2 {
+		t.Fatalf("expected 2 known harbors, got %+v (%v)", harbors, err)
+	}
+	if lat, lng, err := harbors[1].Coordinates(); err != nil || lat > -23 || lng > -46 {
+		t.Errorf("expected Santos coordinates, got %f, %f (%v)", lat, lng, err)
+	}
+
+	dayRange, _ := tabuamare.ParseDayRange("[1-3,10]")
+	tables, err := client.GetTideTableForDayRange(ctx, 1, 2, dayRange)
+	if err != nil || len(tables) != 1 {
+		t.Fatalf("expected one tide table, got %+v (%v)", tables, err)
+	}
+	days := tables[0].Months[0].Days
+	if len(days) != 4 || days[3].Day != 10 || len(days[0].Hours) < 3 {
+		t.Errorf("expected days 1-3 and 10 with tide events, got %+v", days)
+	}
+	if _, err := tables[0].Events(); err != nil {
+		t.Errorf("expected parseable events, got %v", err)
+	}
+
+	nearest, err := client.GetNearestHarbor(ctx, -23.55, -46.63)
+	if err != nil || nearest.ID != 5 || nearest.Distance < 40 || nearest.Distance > 60 {
+		t.Errorf("expected Santos about 50 km away, got %+v (%v)", nearest, err)
+	}
+
+	catalog, err := client.LoadCatalog(ctx)
+	if err != nil || catalog.Len() != 6 {
+		t.Errorf("expected a catalog with 6 harbors, got %v", err)
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	server := NewServer(nil)
+	defer server.Close()
+
+	client := server.NewClient()
+	ctx := context.Background()
+
+	if _, err := client.GetTideTable(ctx, 99, 1, []int{1}); !tabuamare.IsNotFound(err) {
+		t.Errorf("expected ErrNotFound for an unknown harbor, got %v", err)
+	}
+
+	resp, err := http.Get(server.URL + "/tabua-mare/1/1/[5-2]")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected 400 for an invalid day range, got %d", resp.StatusCode)
+	}
+}
+
+func TestServer_Faults(t *testing.T) {
+	server := NewServer(nil)
+	defer server.Close()
+
+	ctx := context.Background()
+	policy := tabuamare.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+	client := server.NewClient(tabuamare.WithRetryPolicy(policy))
+
+	server.InjectFault(Fault{Path: "/states", Status: http.StatusTooManyRequests, RetryAfter: time.Second, Times: 2})
+	if _, err := client.GetStates(ctx); err != nil {
+		t.Fatalf("expected the retries to succeed, got %v", err)
+	}
+	if got := len(server.Requests()); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+
+	server.InjectFault(Fault{Status: http.StatusServiceUnavailable})
+	if _, err := client.GetHarbor(ctx, 1); !errors.Is(err, tabuamare.ErrServerError) {
+		t.Errorf("expected ErrServerError, got %v", err)
+	}
+	server.ClearFaults()
+
+	server.InjectFault(Fault{Path: "/harbors/", MalformedJSON: true, Times: 1})
+	if _, err := client.GetHarbor(ctx, 1); err == nil || !strings.Contains(err.Error(), "unmarshal") {
+		t.Errorf("expected an unmarshal error, got %v", err)
+	}
+
+	server.InjectFault(Fault{Latency: time.Second, Times: 1})
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err := server.NewClient().GetStates(timeoutCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a deadline error, got %v", err)
+	}
+
+	if _, err := client.GetHarbor(ctx, 1); err != nil {
+		t.Errorf("expected the faults to be exhausted, got %v", err)
+	}
+}
+
+func TestSyntheticTides(t *testing.T) {
+	months := SyntheticTides(2024, 1, 1.0)
+	if len(months) != 12 || len(months[1].Days) != 29 {
+		t.Fatalf("expected 12 months with a leap February, got %d months", len(months))
+	}
+
+	for _, month := range months {
+		for _, day := range month.Days {
+			if len(day.Hours) < 3 || len(day.Hours) > 5 {
+				t.Fatalf("expected 3 to 5 extremes on %d/%d, got %d", day.Day, month.Month, len(day.Hours))
+			}
+		}
+	}
+}
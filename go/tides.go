@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // DayRange representa um intervalo de dias ou dias específicos
@@ -14,31 +16,32 @@ type DayRange struct {
 	days []int
 }
 
-// NewDayRange cria um novo DayRange a partir de dias específicos
+// NewDayRange cria um novo DayRange a partir de dias específicos; dias repetidos
+// são descartados e os demais ficam em ordem crescente
 func NewDayRange(days ...int) (*DayRange, error) {
 	if len(days) == 0 {
-		return nil, &ValidationError{Field: "days", Message: "at least one day is required"}
+		return nil, &ValidationError{Field: "days", Message: "at least one day is required", Err: ErrInvalidDays}
 	}
 
 	for _, day := range days {
 		if day < 1 || day > 31 {
-			return nil, &ValidationError{Field: "days", Message: "days must be between 1 and 31"}
+			return nil, &ValidationError{Field: "days", Message: "days must be between 1 and 31", Err: ErrInvalidDays}
 		}
 	}
 
-	return &DayRange{days: days}, nil
+	return &DayRange{days: normalizeDays(days)}, nil
 }
 
 // NewDayRangeFromInterval cria um DayRange a partir de um intervalo (ex: 1-15)
 func NewDayRangeFromInterval(start, end int) (*DayRange, error) {
 	if start < 1 || start > 31 {
-		return nil, &ValidationError{Field: "start", Message: "start day must be between 1 and 31"}
+		return nil, &ValidationError{Field: "start", Message: "start day must be between 1 and 31", Err: ErrInvalidDays}
 	}
 	if end < 1 || end > 31 {
-		return nil, &ValidationError{Field: "end", Message: "end day must be between 1 and 31"}
+		return nil, &ValidationError{Field: "end", Message: "end day must be between 1 and 31", Err: ErrInvalidDays}
 	}
 	if start > end {
-		return nil, &ValidationError{Field: "days", Message: "start day must be less than or equal to end day"}
+		return nil, &ValidationError{Field: "days", Message: "start day must be less than or equal to end day", Err: ErrInvalidDays}
 	}
 
 	days := make([]int, 0, end-start+1)
@@ -49,69 +52,183 @@ func NewDayRangeFromInterval(start, end int) (*DayRange, error) {
 	return &DayRange{days: days}, nil
 }
 
-// String retorna a representação em string do DayRange no formato esperado pela API
+// ParseDayRange interpreta um DayRange na sintaxe da API, como "[1,5-13,20,25-30]".
+// Os colchetes são opcionais.
+func ParseDayRange(s string) (*DayRange, error) {
+	value := strings.TrimSpace(s)
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+
+	var days []int
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil, &ValidationError{Field: "days", Message: fmt.Sprintf("empty element in %q", s), Err: ErrInvalidDays}
+		}
+
+		first, last, isInterval := strings.Cut(part, "-")
+		start, err := strconv.Atoi(strings.TrimSpace(first))
+		if err != nil {
+			return nil, &ValidationError{Field: "days", Message: fmt.Sprintf("invalid day %q in %q", part, s), Err: ErrInvalidDays}
+		}
+
+		end := start
+		if isInterval {
+			if end, err = strconv.Atoi(strings.TrimSpace(last)); err != nil {
+				return nil, &ValidationError{Field: "days", Message: fmt.Sprintf("invalid interval %q in %q", part, s), Err: ErrInvalidDays}
+			}
+		}
+
+		interval, err := NewDayRangeFromInterval(start, end)
+		if err != nil {
+			return nil, err
+		}
+		days = append(days, interval.days...)
+	}
+
+	return NewDayRange(days...)
+}
+
+// Days retorna uma cópia dos dias do DayRange, em ordem crescente
+func (dr *DayRange) Days() []int {
+	return append([]int(nil), dr.days...)
+}
+
+// Contains informa se o dia faz parte do DayRange
+func (dr *DayRange) Contains(day int) bool {
+	i := sort.SearchInts(dr.days, day)
+	return i < len(dr.days) && dr.days[i] == day
+}
+
+// Union retorna um DayRange com os dias presentes em qualquer um dos dois
+func (dr *DayRange) Union(other *DayRange) *DayRange {
+	days := append(append([]int(nil), dr.days...), other.days...)
+	return &DayRange{days: normalizeDays(days)}
+}
+
+// Intersect retorna um DayRange com os dias presentes nos dois; o resultado pode ser vazio
+func (dr *DayRange) Intersect(other *DayRange) *DayRange {
+	var days []int
+	for _, day := range dr.days {
+		if other.Contains(day) {
+			days = append(days, day)
+		}
+	}
+	return &DayRange{days: days}
+}
+
+// String retorna a representação em string do DayRange no formato esperado pela API,
+// agrupando sequências de três ou mais dias consecutivos (ex: "[1,5-13,20]")
 func (dr *DayRange) String() string {
 	if len(dr.days) == 0 {
 		return "[]"
 	}
 
-	parts := make([]string, len(dr.days))
-	for i, day := range dr.days {
-		parts[i] = strconv.Itoa(day)
+	var parts []string
+	for start := 0; start < len(dr.days); {
+		end := start
+		for end+1 < len(dr.days) && dr.days[end+1] == dr.days[end]+1 {
+			end++
+		}
+
+		switch {
+		case end-start >= 2:
+			parts = append(parts, fmt.Sprintf("%d-%d", dr.days[start], dr.days[end]))
+		case end > start:
+			parts = append(parts, strconv.Itoa(dr.days[start]), strconv.Itoa(dr.days[end]))
+		default:
+			parts = append(parts, strconv.Itoa(dr.days[start]))
+		}
+		start = end + 1
 	}
 
 	return fmt.Sprintf("[%s]", strings.Join(parts, ","))
 }
 
+// normalizeDays retorna os dias em ordem crescente e sem repetições
+func normalizeDays(days []int) []int {
+	sorted := append([]int(nil), days...)
+	sort.Ints(sorted)
+
+	unique := sorted[:0]
+	for _, day := range sorted {
+		if n := len(unique); n == 0 || unique[n-1] != day {
+			unique = append(unique, day)
+		}
+	}
+	return unique
+}
+
 // GetTideTable retorna a tábua de marés para um porto, mês e dias específicos
 func (c *Client) GetTideTable(ctx context.Context, harborID, month int, days []int) ([]TideTable, error) {
-	if harborID <= 0 {
-		return nil, &ValidationError{Field: "harborID", Message: "harbor ID must be a positive integer"}
+	dayRange, err := NewDayRange(days...)
+	if err != nil {
+		return nil, err
 	}
 
+	return c.GetTideTableForDayRange(ctx, harborID, month, dayRange)
+}
+
+// GetTideTableForMonth retorna a tábua de marés para um mês inteiro. Como o ano dos
+// dados só é conhecido na resposta, são solicitados os dias do mês em um ano bissexto,
+// e 29 de fevereiro é descartado quando o ano dos dados não é bissexto.
+func (c *Client) GetTideTableForMonth(ctx context.Context, harborID, month int) ([]TideTable, error) {
 	if month < 1 || month > 12 {
 		return nil, ErrInvalidMonth
 	}
 
-	dayRange, err := NewDayRange(days...)
+	dayRange, err := NewDayRangeForMonth(leapYear, month, monthDays(leapYear, month)...)
 	if err != nil {
 		return nil, err
 	}
 
-	path := fmt.Sprintf("/tabua-mare/%d/%d/%s", harborID, month, url.PathEscape(dayRange.String()))
-
-	body, err := c.doRequest(ctx, "GET", path)
+	tables, err := c.GetTideTableForDayRange(ctx, harborID, month, dayRange)
 	if err != nil {
 		return nil, err
 	}
 
-	var response TideTableResponse
-	if err := json.Unmarshal(body, &response); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	for i := range tables {
+		trimToCalendar(&tables[i])
 	}
+	return tables, nil
+}
 
-	if response.Error != nil {
-		return nil, response.Error
+// trimToCalendar remove os dias inexistentes no calendário do ano da tábua
+func trimToCalendar(table *TideTable) {
+	if table.Year <= 0 {
+		return
 	}
 
-	return response.Data, nil
-}
+	for i := range table.Months {
+		month := &table.Months[i]
+		if month.Month < 1 || month.Month > 12 {
+			continue
+		}
 
-// GetTideTableForMonth retorna a tábua de marés para um mês inteiro
-func (c *Client) GetTideTableForMonth(ctx context.Context, harborID, month int) ([]TideTable, error) {
-	dayRange, err := NewDayRangeFromInterval(1, 31)
-	if err != nil {
-		return nil, err
+		last := DaysInMonth(table.Year, month.Month)
+		days := month.Days[:0]
+		for _, day := range month.Days {
+			if day.Day <= last {
+				days = append(days, day)
+			}
+		}
+		month.Days = days
 	}
+}
 
+// GetTideTableForDayRange retorna a tábua de marés para um porto, mês e DayRange
+func (c *Client) GetTideTableForDayRange(ctx context.Context, harborID, month int, dayRange *DayRange) ([]TideTable, error) {
 	if harborID <= 0 {
-		return nil, &ValidationError{Field: "harborID", Message: "harbor ID must be a positive integer"}
+		return nil, &ValidationError{Field: "harborID", Message: "harbor ID must be a positive integer", Err: ErrInvalidHarborID}
 	}
 
 	if month < 1 || month > 12 {
 		return nil, ErrInvalidMonth
 	}
 
+	if dayRange == nil || len(dayRange.days) == 0 {
+		return nil, &ValidationError{Field: "days", Message: "at least one day is required", Err: ErrInvalidDays}
+	}
+
 	path := fmt.Sprintf("/tabua-mare/%d/%d/%s", harborID, month, url.PathEscape(dayRange.String()))
 
 	body, err := c.doRequest(ctx, "GET", path)
@@ -130,3 +247,125 @@ func (c *Client) GetTideTableForMonth(ctx context.Context, harborID, month int)
 
 	return response.Data, nil
 }
+
+// monthWindow representa os dias solicitados de um mês em uma consulta por período
+type monthWindow struct {
+	year  int // ano solicitado; 0 aceita o ano dos dados informado pela API
+	month int
+	days  []int
+}
+
+// GetTidesBetween retorna a tábua de marés de um porto entre as datas from e to
+// (inclusive). O período é dividido em uma requisição por mês, executadas em
+// paralelo (veja WithMaxConcurrency), e o resultado é uma única tábua em ordem
+// cronológica. Meses fora do ano dos dados da API resultam em ErrTimeOutOfRange.
+func (c *Client) GetTidesBetween(ctx context.Context, harborID int, from, to time.Time) (*TideTable, error) {
+	if harborID <= 0 {
+		return nil, &ValidationError{Field: "harborID", Message: "harbor ID must be a positive integer", Err: ErrInvalidHarborID}
+	}
+
+	windows, err := splitByMonth(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([][]TideTable, len(windows))
+	errs := make([]error, len(windows))
+
+	c.runPool(len(windows), func(i int) {
+		results[i], errs[i] = c.GetTideTable(ctx, harborID, windows[i].month, windows[i].days)
+		if errs[i] != nil {
+			cancel()
+		}
+	})
+
+	if err := firstError(errs); err != nil {
+		return nil, err
+	}
+
+	return mergeTideTables(windows, results)
+}
+
+// splitByMonth divide o período entre as datas de from e to em janelas mensais
+func splitByMonth(from, to time.Time) ([]monthWindow, error) {
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	end := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+
+	if end.Before(start) {
+		return nil, &ValidationError{Field: "to", Message: "to must not be before from"}
+	}
+	if !end.Before(start.AddDate(1, 0, 0)) {
+		return nil, &ValidationError{Field: "to", Message: "period must be shorter than one year"}
+	}
+
+	var windows []monthWindow
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		n := len(windows)
+		if n == 0 || windows[n-1].month != int(day.Month()) {
+			windows = append(windows, monthWindow{year: day.Year(), month: int(day.Month())})
+			n++
+		}
+		windows[n-1].days = append(windows[n-1].days, day.Day())
+	}
+
+	return windows, nil
+}
+
+// mergeTideTables junta as tábuas de cada janela em uma só, descartando os dias
+// que a API retornou fora das janelas solicitadas
+func mergeTideTables(windows []monthWindow, results [][]TideTable) (*TideTable, error) {
+	var merged *TideTable
+
+	for i, window := range windows {
+		requested := make(map[int]bool, len(window.days))
+		for _, day := range window.days {
+			requested[day] = true
+		}
+
+		month := TideMonth{Month: window.month}
+		for _, table := range results[i] {
+			if window.year != 0 && table.Year != 0 && table.Year != window.year {
+				return nil, fmt.Errorf("%w: %04d-%02d is not in the data year %d", ErrTimeOutOfRange, window.year, window.month, table.Year)
+			}
+
+			if merged == nil {
+				metadata := table
+				metadata.Months = nil
+				merged = &metadata
+			}
+
+			for _, tableMonth := range table.Months {
+				if tableMonth.Month != window.month {
+					continue
+				}
+				if month.MonthName == "" {
+					month.MonthName = tableMonth.MonthName
+				}
+				for _, day := range tableMonth.Days {
+					if requested[day.Day] {
+						month.Days = append(month.Days, day)
+						delete(requested, day.Day)
+					}
+				}
+			}
+		}
+
+		if len(month.Days) == 0 {
+			continue
+		}
+
+		sort.Slice(month.Days, func(a, b int) bool {
+			return month.Days[a].Day < month.Days[b].Day
+		})
+		merged.Months = append(merged.Months, month)
+	}
+
+	if merged == nil {
+		return nil, ErrEmptyResponse
+	}
+
+	return merged, nil
+}
@@ -0,0 +1,184 @@
+package tabuamare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newMonthServer responde a /tabua-mare com todos os dias de 1 a 31 do mês solicitado,
+// ignorando os dias pedidos
+func newMonthServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(r.URL.Path, "/")
+		month, err := strconv.Atoi(parts[3])
+		if err != nil {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		days := make([]TideDay, 0, 31)
+		for day := 1; day <= 31; day++ {
+			days = append(days, TideDay{Day: day, Hours: []TideHour{{Hour: "06:00:00", Level: 1.5}}})
+		}
+
+		response := TideTableResponse{
+			Data: []TideTable{{
+				Year:       2025,
+				HarborName: "PORTO DE TESTE",
+				Timezone:   "UTC -03.0",
+				Months:     []TideMonth{{Month: month, MonthName: time.Month(month).String(), Days: days}},
+			}},
+			Total: 1,
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func TestGetTidesBetween_SpansMonths(t *testing.T) {
+	server := newMonthServer(t)
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	from := time.Date(2025, time.January, 27, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, time.February, 5, 0, 0, 0, 0, time.UTC)
+
+	table, err := client.GetTidesBetween(context.Background(), 1, from, to)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if table.HarborName != "PORTO DE TESTE" || table.Year != 2025 {
+		t.Errorf("unexpected metadata: %+v", table)
+	}
+	if len(table.Months) != 2 || table.Months[0].Month != 1 || table.Months[1].Month != 2 {
+		t.Fatalf("expected January and February, got %+v", table.Months)
+	}
+	if n := len(table.Months[0].Days); n != 5 || table.Months[0].Days[0].Day != 27 {
+		t.Errorf("expected January 27-31, got %d days starting at %d", n, table.Months[0].Days[0].Day)
+	}
+	if n := len(table.Months[1].Days); n != 5 || table.Months[1].Days[4].Day != 5 {
+		t.Errorf("expected February 1-5, got %d days", n)
+	}
+}
+
+func TestGetTidesBetween_OutsideDataYear(t *testing.T) {
+	server := newMonthServer(t)
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+	from := time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := client.GetTidesBetween(context.Background(), 1, from, to); !errors.Is(err, ErrTimeOutOfRange) {
+		t.Errorf("expected ErrTimeOutOfRange for months outside the data year, got %v", err)
+	}
+
+	from = time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := client.GetTidesBetween(context.Background(), 1, from, from); !errors.Is(err, ErrTimeOutOfRange) {
+		t.Errorf("expected ErrTimeOutOfRange for a year the API does not serve, got %v", err)
+	}
+}
+
+func TestGetTidesBetween_InvalidRange(t *testing.T) {
+	client := NewClient()
+	from := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name string
+		to   time.Time
+	}{
+		{"to before from", from.AddDate(0, 0, -1)},
+		{"longer than a year", from.AddDate(1, 0, 0)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var valErr *ValidationError
+			if _, err := client.GetTidesBetween(context.Background(), 1, from, tc.to); !errors.As(err, &valErr) {
+				t.Errorf("expected ValidationError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDayRange_StringCompressesRuns(t *testing.T) {
+	testCases := []struct {
+		days []int
+		want string
+	}{
+		{[]int{1}, "[1]"},
+		{[]int{3, 1, 2}, "[1-3]"},
+		{[]int{1, 2}, "[1,2]"},
+		{[]int{20, 1, 5, 6, 7, 8, 9, 10, 11, 12, 13, 5, 25, 26, 27, 28, 29, 30}, "[1,5-13,20,25-30]"},
+	}
+
+	for _, tc := range testCases {
+		dayRange, err := NewDayRange(tc.days...)
+		if err != nil {
+			t.Fatalf("%v: expected no error, got %v", tc.days, err)
+		}
+		if got := dayRange.String(); got != tc.want {
+			t.Errorf("%v: expected %s, got %s", tc.days, tc.want, got)
+		}
+	}
+}
+
+func TestParseDayRange(t *testing.T) {
+	dayRange, err := ParseDayRange("[1, 5-7,20,6]")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := []int{1, 5, 6, 7, 20}
+	got := dayRange.Days()
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+
+	if roundTrip, err := ParseDayRange(dayRange.String()); err != nil || roundTrip.String() != dayRange.String() {
+		t.Errorf("expected %s to round-trip, got %v (%v)", dayRange, roundTrip, err)
+	}
+	if _, err := ParseDayRange("12"); err != nil {
+		t.Errorf("expected brackets to be optional, got %v", err)
+	}
+}
+
+func TestParseDayRange_Invalid(t *testing.T) {
+	for _, input := range []string{"[]", "[1,,2]", "[a]", "[5-2]", "[0]", "[1-32]", "[-3]"} {
+		var valErr *ValidationError
+		if _, err := ParseDayRange(input); !errors.As(err, &valErr) {
+			t.Errorf("%q: expected ValidationError, got %v", input, err)
+		}
+	}
+}
+
+func TestDayRange_SetOperations(t *testing.T) {
+	a, _ := ParseDayRange("[1-10]")
+	b, _ := ParseDayRange("[8-12,20]")
+
+	if got := a.Union(b).String(); got != "[1-12,20]" {
+		t.Errorf("expected union [1-12,20], got %s", got)
+	}
+	if got := a.Intersect(b).String(); got != "[8-10]" {
+		t.Errorf("expected intersection [8-10], got %s", got)
+	}
+	if !a.Contains(10) || a.Contains(11) {
+		t.Error("unexpected Contains result")
+	}
+
+	c, _ := ParseDayRange("[25]")
+	if got := a.Intersect(c); len(got.Days()) != 0 || got.String() != "[]" {
+		t.Errorf("expected empty intersection, got %s", got)
+	}
+}
@@ -0,0 +1,144 @@
+package tabuamare
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseTimezone converte o fuso horário retornado pela API (ex: "UTC -03.0") em um
+// *time.Location de deslocamento fixo. Aceita também variações como "UTC-3",
+// "GMT -03:00", "-0330", "UTC +5.75" e nomes IANA como "America/Recife".
+func ParseTimezone(tz string) (*time.Location, error) {
+	value := strings.TrimSpace(strings.ReplaceAll(tz, "−", "-"))
+	if strings.Contains(value, "/") {
+		loc, err := time.LoadLocation(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidTimezone, tz)
+		}
+		return loc, nil
+	}
+
+	value = strings.ToUpper(value)
+	for _, prefix := range []string{"UTC", "GMT"} {
+		value = strings.TrimPrefix(value, prefix)
+	}
+	value = strings.Join(strings.Fields(value), "")
+
+	if value == "" || value == "Z" {
+		return time.UTC, nil
+	}
+
+	seconds, err := parseOffset(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimezone, tz)
+	}
+
+	return time.FixedZone(formatOffset(seconds), seconds), nil
+}
+
+// parseOffset converte "-03.0", "-3", "-03:30" ou "-0330" em segundos a leste de UTC
+func parseOffset(value string) (int, error) {
+	sign := 1
+	switch value[0] {
+	case '-':
+		sign = -1
+		value = value[1:]
+	case '+':
+		value = value[1:]
+	}
+
+	var hours, minutes float64
+	var err error
+
+	switch {
+	case strings.Contains(value, ":"):
+		hh, mm, _ := strings.Cut(value, ":")
+		if hours, err = parseOffsetPart(hh); err != nil {
+			return 0, err
+		}
+		if minutes, err = parseOffsetPart(mm); err != nil || minutes >= 60 {
+			return 0, ErrInvalidTimezone
+		}
+	case strings.ContainsAny(value, ".,"):
+		if hours, err = strconv.ParseFloat(strings.Replace(value, ",", ".", 1), 64); err != nil {
+			return 0, err
+		}
+	case len(value) == 4:
+		if hours, err = parseOffsetPart(value[:2]); err != nil {
+			return 0, err
+		}
+		if minutes, err = parseOffsetPart(value[2:]); err != nil || minutes >= 60 {
+			return 0, ErrInvalidTimezone
+		}
+	default:
+		if hours, err = parseOffsetPart(value); err != nil {
+			return 0, err
+		}
+	}
+
+	offset := hours*3600 + minutes*60
+	if math.IsNaN(offset) || offset < 0 || offset > 14*3600 {
+		return 0, ErrInvalidTimezone
+	}
+
+	return sign * int(math.Round(offset)), nil
+}
+
+// parseOffsetPart converte um componente inteiro de um deslocamento
+func parseOffsetPart(value string) (float64, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, ErrInvalidTimezone
+	}
+	return float64(n), nil
+}
+
+// formatOffset formata um deslocamento em segundos como "UTC-03:00"
+func formatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	return fmt.Sprintf("UTC%s%02d:%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+// Location retorna o fuso horário do porto. Quando o estado e o deslocamento
+// identificam uma única zona IANA (ex: America/Recife), ela é retornada; caso
+// contrário, o resultado é uma zona de deslocamento fixo.
+func (h Harbor) Location() (*time.Location, error) {
+	return resolveLocation(h.Timezone, h.State, time.Now())
+}
+
+// Location retorna o fuso horário da tábua de marés, seguindo as mesmas regras de Harbor.Location
+func (t TideTable) Location() (*time.Location, error) {
+	ref := time.Now()
+	if t.Year > 0 {
+		ref = time.Date(t.Year, time.July, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return resolveLocation(t.Timezone, t.State, ref)
+}
+
+// resolveLocation interpreta tz e tenta mapeá-lo para a zona IANA do estado na data ref
+func resolveLocation(tz, state string, ref time.Time) (*time.Location, error) {
+	fixed, err := ParseTimezone(tz)
+	if err != nil {
+		return nil, err
+	}
+
+	_, offset := ref.In(fixed).Zone()
+	for _, name := range stateInfos[State(strings.ToLower(state))].timezones {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			continue
+		}
+		if _, zoneOffset := ref.In(loc).Zone(); zoneOffset == offset {
+			return loc, nil
+		}
+	}
+
+	return fixed, nil
+}
@@ -0,0 +1,76 @@
+package tabuamare
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseTimezone(t *testing.T) {
+	testCases := []struct {
+		tz     string
+		offset int
+	}{
+		{"UTC -03.0", -3 * 3600},
+		{"UTC-3", -3 * 3600},
+		{"utc - 02.0", -2 * 3600},
+		{"GMT -03:00", -3 * 3600},
+		{"UTC +05.75", 5*3600 + 45*60},
+		{"UTC -03.5", -(3*3600 + 30*60)},
+		{"UTC −03,5", -(3*3600 + 30*60)},
+		{"-0330", -(3*3600 + 30*60)},
+		{"+3", 3 * 3600},
+		{"UTC", 0},
+		{"UTC 0.0", 0},
+	}
+
+	ref := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range testCases {
+		t.Run(tc.tz, func(t *testing.T) {
+			loc, err := ParseTimezone(tc.tz)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if _, offset := ref.In(loc).Zone(); offset != tc.offset {
+				t.Errorf("expected offset %d, got %d", tc.offset, offset)
+			}
+		})
+	}
+}
+
+func TestParseTimezone_Invalid(t *testing.T) {
+	for _, tz := range []string{"UTC -15", "UTC -03:75", "horário de Brasília", "UTC --3", "Mars/Olympus_Mons"} {
+		if _, err := ParseTimezone(tz); !errors.Is(err, ErrInvalidTimezone) {
+			t.Errorf("%q: expected ErrInvalidTimezone, got %v", tz, err)
+		}
+	}
+}
+
+func TestHarbor_Location(t *testing.T) {
+	if _, err := time.LoadLocation("America/Recife"); err != nil {
+		t.Skip("IANA time zone database not available")
+	}
+
+	testCases := []struct {
+		state string
+		tz    string
+		want  string
+	}{
+		{"pe", "UTC -03.0", "America/Recife"},
+		{"pe", "UTC -02.0", "America/Noronha"},
+		{"sp", "UTC -03.0", "America/Sao_Paulo"},
+		{"al", "UTC -02.0", "UTC-02:00"},
+		{"", "UTC -03.0", "UTC-03:00"},
+	}
+
+	for _, tc := range testCases {
+		harbor := Harbor{State: tc.state, Timezone: tc.tz}
+		loc, err := harbor.Location()
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if loc.String() != tc.want {
+			t.Errorf("state %q, tz %q: expected %s, got %s", tc.state, tc.tz, tc.want, loc)
+		}
+	}
+}
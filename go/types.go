@@ -92,7 +92,12 @@ type TideTableResponse struct {
 // NearestHarbor representa o porto mais próximo de uma coordenada
 type NearestHarbor struct {
 	Harbor
-	Distance float64 `json:"distance_km"`
+	Distance  float64 `json:"distance_km"`
+	Bearing   float64 `json:"bearing"`   // rumo inicial, em graus, do ponto consultado até o porto
+	Direction string  `json:"direction"` // direção na rosa dos ventos (ex: "NNE")
+	// FromIndex informa que o porto veio do índice local configurado com WithHarborIndex,
+	// e não da resposta da API
+	FromIndex bool `json:"from_index,omitempty"`
 }
 
 // NearestHarborResponse representa a resposta da consulta de porto mais próximo